@@ -0,0 +1,65 @@
+// Command ethsig-demo walks through generating an Ethereum key pair,
+// signing a message, verifying the signature, and recovering the signer's
+// address, to demonstrate the github.com/pavlenkotm/Reflekt/go/ethsig package.
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/pavlenkotm/Reflekt/go/ethsig"
+)
+
+func main() {
+	sv := ethsig.NewSignatureVerifier()
+
+	fmt.Println("🔐 Ethereum Signature Verifier")
+	fmt.Println("================================\n")
+
+	// Generate new key pair
+	fmt.Println("1️⃣  Generating new key pair...")
+	address, privateKey, err := sv.GenerateKeyPair()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("   Address: %s\n", address)
+	fmt.Printf("   Private Key: %s\n\n", privateKey)
+
+	// Sign message
+	message := "Hello, Ethereum!"
+	fmt.Printf("2️⃣  Signing message: %q\n", message)
+	signature, err := sv.SignMessage(privateKey, message)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("   Signature: %s\n\n", signature)
+
+	// Verify signature
+	fmt.Println("3️⃣  Verifying signature...")
+	valid, err := sv.VerifySignature(address, message, signature)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if valid {
+		fmt.Println("   ✅ Signature is valid!")
+	} else {
+		fmt.Println("   ❌ Signature is invalid!")
+	}
+
+	// Recover address
+	fmt.Println("\n4️⃣  Recovering address from signature...")
+	recoveredAddress, err := sv.RecoverAddress(message, signature)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("   Recovered Address: %s\n", recoveredAddress)
+	fmt.Printf("   Original Address:  %s\n", address)
+	if recoveredAddress == address {
+		fmt.Println("   ✅ Addresses match!")
+	}
+
+	// Hash message
+	fmt.Println("\n5️⃣  Hashing message...")
+	hash := sv.HashMessage(message)
+	fmt.Printf("   Message Hash: %s\n", hash)
+}
@@ -0,0 +1,44 @@
+// Package ante provides a composable chain of AnteHandler-style decorators
+// that can wrap token module message execution with cross-cutting concerns
+// (fees, rate limiting, pausing, signature verification) without editing
+// TokenKeeper's methods themselves.
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Msg is the minimal message shape decorators operate on.
+type Msg interface {
+	ValidateBasic() error
+}
+
+// AnteHandler is the continuation passed through a decorator chain. The
+// final handler in a chain returns the context unchanged and a nil error.
+type AnteHandler func(ctx sdk.Context, msg Msg, simulate bool) (sdk.Context, error)
+
+// Decorator wraps msg handling with a single cross-cutting concern, calling
+// next to continue down the chain.
+type Decorator interface {
+	AnteHandle(ctx sdk.Context, msg Msg, simulate bool, next AnteHandler) (sdk.Context, error)
+}
+
+// ChainDecorators composes decorators into a single AnteHandler, each
+// wrapping the next in the order given. The last decorator's next is a
+// terminal handler that returns the context unchanged.
+func ChainDecorators(decorators ...Decorator) AnteHandler {
+	return chainFrom(decorators, 0)
+}
+
+func chainFrom(decorators []Decorator, i int) AnteHandler {
+	if i == len(decorators) {
+		return func(ctx sdk.Context, msg Msg, simulate bool) (sdk.Context, error) {
+			return ctx, nil
+		}
+	}
+
+	next := chainFrom(decorators, i+1)
+	return func(ctx sdk.Context, msg Msg, simulate bool) (sdk.Context, error) {
+		return decorators[i].AnteHandle(ctx, msg, simulate, next)
+	}
+}
@@ -0,0 +1,80 @@
+package ante
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/store"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type stubMsg struct {
+	valid bool
+}
+
+func (m stubMsg) ValidateBasic() error {
+	if !m.valid {
+		return errInvalid
+	}
+	return nil
+}
+
+var errInvalid = fmt.Errorf("stub message is invalid")
+
+func setupAnteCtx(t *testing.T) (sdk.Context, sdk.StoreKey) {
+	storeKey := storetypes.NewKVStoreKey("ante")
+	db := store.NewCommitMultiStore(nil, nil)
+	ctx := sdk.NewContext(db, false, nil)
+	return ctx, storeKey
+}
+
+func TestChainDecoratorsRunsInOrder(t *testing.T) {
+	var order []string
+
+	record := func(name string) Decorator {
+		return recorderDecorator{name: name, order: &order}
+	}
+
+	chain := ChainDecorators(record("first"), record("second"))
+
+	_, err := chain(sdk.Context{}, stubMsg{valid: true}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second"}, order)
+}
+
+type recorderDecorator struct {
+	name  string
+	order *[]string
+}
+
+func (d recorderDecorator) AnteHandle(ctx sdk.Context, msg Msg, simulate bool, next AnteHandler) (sdk.Context, error) {
+	*d.order = append(*d.order, d.name)
+	return next(ctx, msg, simulate)
+}
+
+func TestValidateBasicDecoratorRejectsInvalidMsg(t *testing.T) {
+	chain := ChainDecorators(NewValidateBasicDecorator())
+
+	_, err := chain(sdk.Context{}, stubMsg{valid: false}, false)
+	require.Error(t, err)
+}
+
+func TestPauseDecoratorBlocksWhenPaused(t *testing.T) {
+	ctx, storeKey := setupAnteCtx(t)
+	SetPaused(ctx, storeKey, true)
+
+	chain := ChainDecorators(NewPauseDecorator(storeKey))
+	_, err := chain(ctx, stubMsg{valid: true}, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "paused")
+}
+
+func TestPauseDecoratorAllowsWhenNotPaused(t *testing.T) {
+	ctx, storeKey := setupAnteCtx(t)
+
+	chain := ChainDecorators(NewPauseDecorator(storeKey))
+	_, err := chain(ctx, stubMsg{valid: true}, false)
+	require.NoError(t, err)
+}
@@ -0,0 +1,20 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type validateBasicDecorator struct{}
+
+// NewValidateBasicDecorator returns a Decorator that rejects a message
+// failing its own ValidateBasic before it reaches the rest of the chain.
+func NewValidateBasicDecorator() Decorator {
+	return validateBasicDecorator{}
+}
+
+func (validateBasicDecorator) AnteHandle(ctx sdk.Context, msg Msg, simulate bool, next AnteHandler) (sdk.Context, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return ctx, err
+	}
+	return next(ctx, msg, simulate)
+}
@@ -0,0 +1,58 @@
+package ante
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeKeeper is the subset of TokenKeeper's behavior the fee decorator needs
+// to move the fee from the signer to the treasury.
+type FeeKeeper interface {
+	Transfer(ctx context.Context, denom string, from, to sdk.AccAddress, amount math.Int) error
+}
+
+// Signer is implemented by messages that declare their required signers,
+// the first of which pays the fee.
+type Signer interface {
+	Msg
+	GetSigners() []sdk.AccAddress
+}
+
+type feeDeductDecorator struct {
+	keeper   FeeKeeper
+	feeDenom string
+	fee      math.Int
+	treasury sdk.AccAddress
+}
+
+// NewFeeDeductDecorator returns a Decorator that charges fee (in feeDenom)
+// from the message's first signer to treasury before continuing the chain.
+// It is a no-op for simulations and for a zero fee.
+func NewFeeDeductDecorator(keeper FeeKeeper, feeDenom string, fee math.Int, treasury sdk.AccAddress) Decorator {
+	return feeDeductDecorator{keeper: keeper, feeDenom: feeDenom, fee: fee, treasury: treasury}
+}
+
+func (d feeDeductDecorator) AnteHandle(ctx sdk.Context, msg Msg, simulate bool, next AnteHandler) (sdk.Context, error) {
+	if simulate || d.fee.IsZero() {
+		return next(ctx, msg, simulate)
+	}
+
+	signer, ok := msg.(Signer)
+	if !ok {
+		return ctx, fmt.Errorf("message %T does not declare a signer to charge the fee to", msg)
+	}
+
+	signers := signer.GetSigners()
+	if len(signers) == 0 {
+		return ctx, fmt.Errorf("message %T has no signers", msg)
+	}
+
+	if err := d.keeper.Transfer(ctx, d.feeDenom, signers[0], d.treasury, d.fee); err != nil {
+		return ctx, fmt.Errorf("deducting fee: %w", err)
+	}
+
+	return next(ctx, msg, simulate)
+}
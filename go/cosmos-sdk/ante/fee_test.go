@@ -0,0 +1,104 @@
+package ante
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// stubFeeKeeper records the args of its last Transfer call, or fails it if
+// failTransfer is set, so tests can assert the fee decorator's behavior
+// without pulling in the token package's real TokenKeeper.
+type stubFeeKeeper struct {
+	failTransfer bool
+
+	called   bool
+	denom    string
+	from, to sdk.AccAddress
+	amount   math.Int
+}
+
+func (k *stubFeeKeeper) Transfer(ctx context.Context, denom string, from, to sdk.AccAddress, amount math.Int) error {
+	if k.failTransfer {
+		return fmt.Errorf("transfer failed")
+	}
+	k.called = true
+	k.denom, k.from, k.to, k.amount = denom, from, to, amount
+	return nil
+}
+
+type feeSignedMsg struct {
+	signer sdk.AccAddress
+}
+
+func (feeSignedMsg) ValidateBasic() error { return nil }
+
+func (m feeSignedMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.signer}
+}
+
+func TestFeeDeductDecoratorChargesFirstSigner(t *testing.T) {
+	ctx, _ := setupAnteCtx(t)
+	keeper := &stubFeeKeeper{}
+	treasury := sdk.AccAddress("treasury")
+	signer := sdk.AccAddress("signer")
+
+	chain := ChainDecorators(NewFeeDeductDecorator(keeper, "stake", math.NewInt(10), treasury))
+
+	_, err := chain(ctx, feeSignedMsg{signer: signer}, false)
+	require.NoError(t, err)
+
+	require.True(t, keeper.called)
+	require.Equal(t, "stake", keeper.denom)
+	require.Equal(t, signer, keeper.from)
+	require.Equal(t, treasury, keeper.to)
+	require.Equal(t, math.NewInt(10), keeper.amount)
+}
+
+func TestFeeDeductDecoratorSkipsSimulation(t *testing.T) {
+	ctx, _ := setupAnteCtx(t)
+	keeper := &stubFeeKeeper{}
+
+	chain := ChainDecorators(NewFeeDeductDecorator(keeper, "stake", math.NewInt(10), sdk.AccAddress("treasury")))
+
+	_, err := chain(ctx, feeSignedMsg{signer: sdk.AccAddress("signer")}, true)
+	require.NoError(t, err)
+	require.False(t, keeper.called)
+}
+
+func TestFeeDeductDecoratorSkipsZeroFee(t *testing.T) {
+	ctx, _ := setupAnteCtx(t)
+	keeper := &stubFeeKeeper{}
+
+	chain := ChainDecorators(NewFeeDeductDecorator(keeper, "stake", math.ZeroInt(), sdk.AccAddress("treasury")))
+
+	_, err := chain(ctx, feeSignedMsg{signer: sdk.AccAddress("signer")}, false)
+	require.NoError(t, err)
+	require.False(t, keeper.called)
+}
+
+func TestFeeDeductDecoratorRejectsMsgWithoutSigners(t *testing.T) {
+	ctx, _ := setupAnteCtx(t)
+	keeper := &stubFeeKeeper{}
+
+	chain := ChainDecorators(NewFeeDeductDecorator(keeper, "stake", math.NewInt(10), sdk.AccAddress("treasury")))
+
+	_, err := chain(ctx, stubMsg{valid: true}, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not declare a signer")
+}
+
+func TestFeeDeductDecoratorPropagatesTransferError(t *testing.T) {
+	ctx, _ := setupAnteCtx(t)
+	keeper := &stubFeeKeeper{failTransfer: true}
+
+	chain := ChainDecorators(NewFeeDeductDecorator(keeper, "stake", math.NewInt(10), sdk.AccAddress("treasury")))
+
+	_, err := chain(ctx, feeSignedMsg{signer: sdk.AccAddress("signer")}, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "deducting fee")
+}
@@ -0,0 +1,44 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// pauseKey is the KV store key holding the module's global pause flag.
+var pauseKey = []byte("params/paused")
+
+type pauseDecorator struct {
+	storeKey sdk.StoreKey
+}
+
+// NewPauseDecorator returns a Decorator that rejects every message while the
+// token module's governance-controlled pause flag is set.
+func NewPauseDecorator(storeKey sdk.StoreKey) Decorator {
+	return pauseDecorator{storeKey: storeKey}
+}
+
+func (d pauseDecorator) AnteHandle(ctx sdk.Context, msg Msg, simulate bool, next AnteHandler) (sdk.Context, error) {
+	if IsPaused(ctx, d.storeKey) {
+		return ctx, fmt.Errorf("token module is paused")
+	}
+	return next(ctx, msg, simulate)
+}
+
+// IsPaused reports whether the token module's global pause flag is set.
+func IsPaused(ctx sdk.Context, storeKey sdk.StoreKey) bool {
+	store := ctx.KVStore(storeKey)
+	return store.Has(pauseKey)
+}
+
+// SetPaused sets or clears the token module's global pause flag. Intended to
+// be called from a governance proposal handler, not directly by users.
+func SetPaused(ctx sdk.Context, storeKey sdk.StoreKey, paused bool) {
+	store := ctx.KVStore(storeKey)
+	if paused {
+		store.Set(pauseKey, []byte{1})
+		return
+	}
+	store.Delete(pauseKey)
+}
@@ -0,0 +1,62 @@
+package ante
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RateLimited is implemented by messages whose transfer amount should count
+// against the per-address per-block cap. Messages that don't implement it
+// are passed through the rate limiter unchanged.
+type RateLimited interface {
+	Msg
+	RateLimitSubject() (addr sdk.AccAddress, amount math.Int)
+}
+
+type rateLimitDecorator struct {
+	storeKey sdk.StoreKey
+	limit    math.Int
+}
+
+// NewRateLimitDecorator returns a Decorator that caps, per block, the total
+// amount any single address may move through RateLimited messages.
+func NewRateLimitDecorator(storeKey sdk.StoreKey, limit math.Int) Decorator {
+	return rateLimitDecorator{storeKey: storeKey, limit: limit}
+}
+
+func (d rateLimitDecorator) AnteHandle(ctx sdk.Context, msg Msg, simulate bool, next AnteHandler) (sdk.Context, error) {
+	limited, ok := msg.(RateLimited)
+	if !ok {
+		return next(ctx, msg, simulate)
+	}
+
+	addr, amount := limited.RateLimitSubject()
+	store := ctx.KVStore(d.storeKey)
+	key := rateLimitKey(ctx.BlockHeight(), addr)
+
+	used := math.ZeroInt()
+	if bz := store.Get(key); bz != nil {
+		if err := used.Unmarshal(bz); err != nil {
+			return ctx, err
+		}
+	}
+
+	newUsed := used.Add(amount)
+	if newUsed.GT(d.limit) {
+		return ctx, fmt.Errorf("%s exceeded the per-block transfer cap of %s", addr, d.limit)
+	}
+
+	bz, err := newUsed.Marshal()
+	if err != nil {
+		return ctx, err
+	}
+	store.Set(key, bz)
+
+	return next(ctx, msg, simulate)
+}
+
+func rateLimitKey(height int64, addr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("ratelimit/%d/%s", height, addr))
+}
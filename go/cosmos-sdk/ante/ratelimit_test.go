@@ -0,0 +1,42 @@
+package ante
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type rateLimitedMsg struct {
+	addr   sdk.AccAddress
+	amount math.Int
+}
+
+func (rateLimitedMsg) ValidateBasic() error { return nil }
+
+func (m rateLimitedMsg) RateLimitSubject() (sdk.AccAddress, math.Int) {
+	return m.addr, m.amount
+}
+
+func TestRateLimitDecoratorBlocksOverCap(t *testing.T) {
+	ctx, storeKey := setupAnteCtx(t)
+	addr := sdk.AccAddress("addr1")
+
+	chain := ChainDecorators(NewRateLimitDecorator(storeKey, math.NewInt(100)))
+
+	_, err := chain(ctx, rateLimitedMsg{addr: addr, amount: math.NewInt(60)}, false)
+	require.NoError(t, err)
+
+	_, err = chain(ctx, rateLimitedMsg{addr: addr, amount: math.NewInt(50)}, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "exceeded the per-block transfer cap")
+}
+
+func TestRateLimitDecoratorIgnoresUnrelatedMsg(t *testing.T) {
+	ctx, storeKey := setupAnteCtx(t)
+
+	chain := ChainDecorators(NewRateLimitDecorator(storeKey, math.NewInt(1)))
+	_, err := chain(ctx, stubMsg{valid: true}, false)
+	require.NoError(t, err)
+}
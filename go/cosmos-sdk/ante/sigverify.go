@@ -0,0 +1,54 @@
+package ante
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/Reflekt/go/ethsig"
+)
+
+// EthSigned is implemented by messages authorized by an off-chain,
+// EIP-191-signed message instead of a Cosmos-native tx signer (see
+// MsgTransferWithPermit). SignBytes is the exact payload that was signed;
+// Signer returns the claimed 0x-address and the hex-encoded signature.
+type EthSigned interface {
+	Msg
+	SignBytes() []byte
+	Signer() (address, signatureHex string)
+}
+
+type sigVerifyDecorator struct {
+	verifier *ethsig.SignatureVerifier
+}
+
+// NewSigVerifyDecorator returns a Decorator that recovers the signer of an
+// EthSigned message's EIP-191 signature, via ethsig.SignatureVerifier, and
+// rejects the message if the recovered address doesn't match the one it
+// claims. Messages that don't implement EthSigned pass through unchanged,
+// since they're expected to be authorized by the enclosing Cosmos tx's
+// signatures instead.
+func NewSigVerifyDecorator() Decorator {
+	return sigVerifyDecorator{verifier: ethsig.NewSignatureVerifier()}
+}
+
+func (d sigVerifyDecorator) AnteHandle(ctx sdk.Context, msg Msg, simulate bool, next AnteHandler) (sdk.Context, error) {
+	signed, ok := msg.(EthSigned)
+	if !ok {
+		return next(ctx, msg, simulate)
+	}
+
+	address, signatureHex := signed.Signer()
+
+	recovered, err := d.verifier.RecoverAddress(string(signed.SignBytes()), signatureHex)
+	if err != nil {
+		return ctx, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	if !strings.EqualFold(recovered, address) {
+		return ctx, fmt.Errorf("signature does not match %s", address)
+	}
+
+	return next(ctx, msg, simulate)
+}
@@ -0,0 +1,86 @@
+package ante
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/Reflekt/go/ethsig"
+)
+
+type ethSignedMsg struct {
+	payload      string
+	address      string
+	signatureHex string
+}
+
+func (ethSignedMsg) ValidateBasic() error { return nil }
+
+func (m ethSignedMsg) SignBytes() []byte { return []byte(m.payload) }
+
+func (m ethSignedMsg) Signer() (address, signatureHex string) {
+	return m.address, m.signatureHex
+}
+
+func signEthPayload(t *testing.T, privateKeyHex, payload string) string {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	require.NoError(t, err)
+
+	digest := ethsig.EthereumSignedMessageHash([]byte(payload))
+	sig, err := crypto.Sign(digest.Bytes(), privateKey)
+	require.NoError(t, err)
+	sig[64] += 27
+
+	return hexutil.Encode(sig)
+}
+
+func TestSigVerifyDecoratorAcceptsValidSignature(t *testing.T) {
+	ctx, _ := setupAnteCtx(t)
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+	payload := "transfer:100:stake"
+	signatureHex := signEthPayload(t, hexutil.Encode(crypto.FromECDSA(privateKey))[2:], payload)
+
+	chain := ChainDecorators(NewSigVerifyDecorator())
+	_, err = chain(ctx, ethSignedMsg{payload: payload, address: address, signatureHex: signatureHex}, false)
+	require.NoError(t, err)
+}
+
+func TestSigVerifyDecoratorRejectsMismatchedSigner(t *testing.T) {
+	ctx, _ := setupAnteCtx(t)
+
+	signerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	claimedKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	payload := "transfer:100:stake"
+	signatureHex := signEthPayload(t, hexutil.Encode(crypto.FromECDSA(signerKey))[2:], payload)
+	address := crypto.PubkeyToAddress(claimedKey.PublicKey).Hex()
+
+	chain := ChainDecorators(NewSigVerifyDecorator())
+	_, err = chain(ctx, ethSignedMsg{payload: payload, address: address, signatureHex: signatureHex}, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not match")
+}
+
+func TestSigVerifyDecoratorRejectsMalformedSignature(t *testing.T) {
+	ctx, _ := setupAnteCtx(t)
+
+	chain := ChainDecorators(NewSigVerifyDecorator())
+	_, err := chain(ctx, ethSignedMsg{payload: "x", address: "0xabc", signatureHex: "not-hex"}, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid signature")
+}
+
+func TestSigVerifyDecoratorIgnoresUnrelatedMsg(t *testing.T) {
+	ctx, _ := setupAnteCtx(t)
+
+	chain := ChainDecorators(NewSigVerifyDecorator())
+	_, err := chain(ctx, stubMsg{valid: true}, false)
+	require.NoError(t, err)
+}
@@ -0,0 +1,25 @@
+package token
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// codespaceToken is the unique codespace registered for the token module's
+// sentinel errors, following the same codespace-per-module convention as
+// the rest of the SDK's builtin error registrations.
+const codespaceToken = "token"
+
+// Sentinel errors for the token module. Each carries a stable numeric code
+// within codespaceToken so callers can do errors.Is(err, token.ErrX) and
+// REST/gRPC clients get a stable error code across upgrades, instead of
+// matching on wrapped ad-hoc error strings.
+var (
+	ErrNegativeAmount         = sdkerrors.Register(codespaceToken, 2, "amount cannot be negative")
+	ErrNonPositiveAmount      = sdkerrors.Register(codespaceToken, 3, "amount must be positive")
+	ErrInsufficientBalance    = sdkerrors.Register(codespaceToken, 4, "insufficient balance")
+	ErrUnknownDenom           = sdkerrors.Register(codespaceToken, 5, "unknown denom")
+	ErrUnauthorized           = sdkerrors.Register(codespaceToken, 6, "unauthorized")
+	ErrInvalidAddress         = sdkerrors.Register(codespaceToken, 7, "invalid address")
+	ErrDenomAlreadyRegistered = sdkerrors.Register(codespaceToken, 8, "denom is already registered")
+	ErrInvalidDenom           = sdkerrors.Register(codespaceToken, 9, "invalid denom")
+)
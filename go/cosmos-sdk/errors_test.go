@@ -0,0 +1,43 @@
+package token
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorsAreProgrammaticallyMatchable(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	admin := sdk.AccAddress("admin")
+	registerTestDenom(t, keeper, ctx, admin)
+
+	addr := sdk.AccAddress("addr1")
+
+	err := keeper.SetBalance(ctx, testDenom, addr, math.NewInt(-1))
+	require.True(t, errors.Is(err, ErrNegativeAmount))
+
+	err = keeper.Transfer(ctx, testDenom, addr, sdk.AccAddress("to"), math.ZeroInt())
+	require.True(t, errors.Is(err, ErrNonPositiveAmount))
+
+	err = keeper.Transfer(ctx, "unknown", addr, sdk.AccAddress("to"), math.NewInt(1))
+	require.True(t, errors.Is(err, ErrUnknownDenom))
+
+	require.NoError(t, keeper.SetBalance(ctx, testDenom, addr, math.NewInt(10)))
+	err = keeper.Transfer(ctx, testDenom, addr, sdk.AccAddress("to"), math.NewInt(100))
+	require.True(t, errors.Is(err, ErrInsufficientBalance))
+
+	err = keeper.Mint(ctx, testDenom, sdk.AccAddress("impostor"), addr, math.NewInt(1))
+	require.True(t, errors.Is(err, ErrUnauthorized))
+
+	err = keeper.RegisterDenom(ctx, Denom{Symbol: "", Admin: admin})
+	require.True(t, errors.Is(err, ErrInvalidDenom))
+
+	err = keeper.RegisterDenom(ctx, Denom{Symbol: "atom"})
+	require.True(t, errors.Is(err, ErrInvalidAddress))
+
+	err = keeper.RegisterDenom(ctx, Denom{Symbol: testDenom, Admin: admin})
+	require.True(t, errors.Is(err, ErrDenomAlreadyRegistered))
+}
@@ -0,0 +1,20 @@
+package token
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/Reflekt/go/cosmos-sdk/ante"
+)
+
+// HandleMsg runs msg through an ante decorator chain before dispatching it
+// to the keeper via NewHandler. Operators compose chain with ante.ChainDecorators
+// to layer policy (fees, rate limits, pausing, off-chain signature checks) in
+// front of TokenKeeper without editing its methods.
+func (k TokenKeeper) HandleMsg(ctx sdk.Context, msg Msg, chain ante.AnteHandler, simulate bool) (*sdk.Result, error) {
+	ctx, err := chain(ctx, msg, simulate)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewHandler(k)(ctx, msg)
+}
@@ -0,0 +1,94 @@
+package token
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Msg is satisfied by every token module message.
+type Msg interface {
+	ValidateBasic() error
+}
+
+// Handler routes a token module message to the keeper method that executes it.
+type Handler func(ctx sdk.Context, msg Msg) (*sdk.Result, error)
+
+// NewHandler returns the token module's message handler. It validates the
+// message, bech32-decodes its addresses, and dispatches to the keeper.
+func NewHandler(k TokenKeeper) Handler {
+	return func(ctx sdk.Context, msg Msg) (*sdk.Result, error) {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		switch msg := msg.(type) {
+		case TransferMsg:
+			return handleTransferMsg(ctx, k, msg)
+		case MintMsg:
+			return handleMintMsg(ctx, k, msg)
+		case BurnMsg:
+			return handleBurnMsg(ctx, k, msg)
+		default:
+			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized token message type: %T", msg)
+		}
+	}
+}
+
+func handleTransferMsg(ctx sdk.Context, k TokenKeeper, msg TransferMsg) (*sdk.Result, error) {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+	to, err := sdk.AccAddressFromBech32(msg.To)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+	amount, ok := parseAmount(msg.Amount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid amount: %s", msg.Amount)
+	}
+
+	if err := k.Transfer(ctx, msg.Denom, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMintMsg(ctx sdk.Context, k TokenKeeper, msg MintMsg) (*sdk.Result, error) {
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid minter address: %s", err)
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	amount, ok := parseAmount(msg.Amount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid amount: %s", msg.Amount)
+	}
+
+	if err := k.Mint(ctx, msg.Denom, minter, recipient, amount); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleBurnMsg(ctx sdk.Context, k TokenKeeper, msg BurnMsg) (*sdk.Result, error) {
+	burner, err := sdk.AccAddressFromBech32(msg.Burner)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid burner address: %s", err)
+	}
+	amount, ok := parseAmount(msg.Amount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid amount: %s", msg.Amount)
+	}
+
+	if err := k.Burn(ctx, msg.Denom, burner, burner, amount); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
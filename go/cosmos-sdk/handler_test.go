@@ -0,0 +1,64 @@
+package token
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func bech32(addr sdk.AccAddress) string {
+	return addr.String()
+}
+
+func TestHandlerTransferMsg(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+
+	from := sdk.AccAddress("from")
+	to := sdk.AccAddress("to")
+	require.NoError(t, keeper.SetBalance(ctx, testDenom, from, math.NewInt(1000)))
+
+	handler := NewHandler(keeper)
+	_, err := handler(ctx, TransferMsg{From: bech32(from), To: bech32(to), Denom: testDenom, Amount: "100"})
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(900), keeper.GetBalance(ctx, testDenom, from))
+	require.Equal(t, math.NewInt(100), keeper.GetBalance(ctx, testDenom, to))
+}
+
+func TestHandlerMintMsgUnauthorized(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+
+	handler := NewHandler(keeper)
+	_, err := handler(ctx, MintMsg{
+		Minter:    bech32(sdk.AccAddress("impostor")),
+		Recipient: bech32(sdk.AccAddress("addr1")),
+		Denom:     testDenom,
+		Amount:    "100",
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not the admin")
+}
+
+func TestHandlerBurnMsgInvalidAddress(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+
+	handler := NewHandler(keeper)
+	_, err := handler(ctx, BurnMsg{Burner: "not-a-bech32-addr", Denom: testDenom, Amount: "100"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid burner address")
+}
+
+func TestHandlerUnrecognizedMsg(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+
+	handler := NewHandler(keeper)
+	_, err := handler(ctx, MsgTransferWithPermit{
+		From: "0xfrom", To: "0xto", Amount: "1", Denom: testDenom, Signature: "0xsig",
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unrecognized token message type")
+}
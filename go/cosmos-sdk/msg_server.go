@@ -0,0 +1,109 @@
+package token
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgServer is the server API for the token module's Msg service.
+type MsgServer interface {
+	Transfer(context.Context, *MsgTransferRequest) (*MsgTransferResponse, error)
+	Mint(context.Context, *MsgMintRequest) (*MsgMintResponse, error)
+	Burn(context.Context, *MsgBurnRequest) (*MsgBurnResponse, error)
+}
+
+type MsgTransferRequest struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+type MsgTransferResponse struct{}
+
+type MsgMintRequest struct {
+	Minter    string `json:"minter"`
+	Recipient string `json:"recipient"`
+	Denom     string `json:"denom"`
+	Amount    string `json:"amount"`
+}
+
+type MsgMintResponse struct{}
+
+type MsgBurnRequest struct {
+	Burner string `json:"burner"`
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+type MsgBurnResponse struct{}
+
+type msgServer struct {
+	keeper TokenKeeper
+}
+
+// NewMsgServerImpl returns an implementation of MsgServer backed by keeper.
+func NewMsgServerImpl(keeper TokenKeeper) MsgServer {
+	return &msgServer{keeper: keeper}
+}
+
+func (m msgServer) Transfer(ctx context.Context, req *MsgTransferRequest) (*MsgTransferResponse, error) {
+	from, err := sdk.AccAddressFromBech32(req.From)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+	to, err := sdk.AccAddressFromBech32(req.To)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+	amount, ok := parseAmount(req.Amount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid amount: %s", req.Amount)
+	}
+
+	if err := m.keeper.Transfer(ctx, req.Denom, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	return &MsgTransferResponse{}, nil
+}
+
+func (m msgServer) Mint(ctx context.Context, req *MsgMintRequest) (*MsgMintResponse, error) {
+	minter, err := sdk.AccAddressFromBech32(req.Minter)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid minter address: %s", err)
+	}
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	amount, ok := parseAmount(req.Amount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid amount: %s", req.Amount)
+	}
+
+	if err := m.keeper.Mint(ctx, req.Denom, minter, recipient, amount); err != nil {
+		return nil, err
+	}
+
+	return &MsgMintResponse{}, nil
+}
+
+func (m msgServer) Burn(ctx context.Context, req *MsgBurnRequest) (*MsgBurnResponse, error) {
+	burner, err := sdk.AccAddressFromBech32(req.Burner)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid burner address: %s", err)
+	}
+	amount, ok := parseAmount(req.Amount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid amount: %s", req.Amount)
+	}
+
+	if err := m.keeper.Burn(ctx, req.Denom, burner, burner, amount); err != nil {
+		return nil, err
+	}
+
+	return &MsgBurnResponse{}, nil
+}
@@ -0,0 +1,72 @@
+package token
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerTransfer(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+
+	from := sdk.AccAddress("from")
+	to := sdk.AccAddress("to")
+	require.NoError(t, keeper.SetBalance(ctx, testDenom, from, math.NewInt(1000)))
+
+	server := NewMsgServerImpl(keeper)
+	_, err := server.Transfer(ctx, &MsgTransferRequest{
+		From: bech32(from), To: bech32(to), Denom: testDenom, Amount: "250",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(750), keeper.GetBalance(ctx, testDenom, from))
+	require.Equal(t, math.NewInt(250), keeper.GetBalance(ctx, testDenom, to))
+}
+
+func TestMsgServerMintAndBurn(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	admin := sdk.AccAddress("admin")
+	registerTestDenom(t, keeper, ctx, admin)
+
+	addr := sdk.AccAddress("addr1")
+	server := NewMsgServerImpl(keeper)
+
+	_, err := server.Mint(ctx, &MsgMintRequest{
+		Minter: bech32(admin), Recipient: bech32(addr), Denom: testDenom, Amount: "500",
+	})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(500), keeper.GetBalance(ctx, testDenom, addr))
+
+	_, err = server.Burn(ctx, &MsgBurnRequest{Burner: bech32(admin), Denom: testDenom, Amount: "0"})
+	require.Error(t, err)
+}
+
+func TestQueryServerBalanceAndSupply(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+
+	addr := sdk.AccAddress("addr1")
+	require.NoError(t, keeper.SetBalance(ctx, testDenom, addr, math.NewInt(750)))
+
+	server := NewQueryServerImpl(keeper)
+
+	balRes, err := server.Balance(ctx, &QueryBalanceRequest{Address: bech32(addr), Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, "750", balRes.Balance)
+
+	supplyRes, err := server.TotalSupply(ctx, &QueryTotalSupplyRequest{Denom: testDenom})
+	require.NoError(t, err)
+	require.Equal(t, "750", supplyRes.Supply)
+
+	allRes, err := server.AllBalances(ctx, &QueryAllBalancesRequest{Address: bech32(addr)})
+	require.NoError(t, err)
+	require.Len(t, allRes.Balances, 1)
+	require.Equal(t, testDenom, allRes.Balances[0].Denom)
+
+	denomsRes, err := server.Denoms(ctx, &QueryDenomsRequest{})
+	require.NoError(t, err)
+	require.Len(t, denomsRes.Denoms, 1)
+}
@@ -0,0 +1,137 @@
+package token
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/Reflekt/go/ethsig"
+)
+
+// signPermit produces an EIP-191 personal_sign signature over a permit's
+// fields, matching what TransferWithPermit expects to recover.
+func signPermit(t *testing.T, privateKeyHex string, msg MsgTransferWithPermit) string {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	require.NoError(t, err)
+
+	payload := fmt.Sprintf("%s:%s:%s:%s:%d:%d", msg.From, msg.To, msg.Amount, msg.Denom, msg.Nonce, msg.Deadline)
+	digest := ethsig.EthereumSignedMessageHash([]byte(payload))
+
+	sig, err := crypto.Sign(digest.Bytes(), privateKey)
+	require.NoError(t, err)
+	sig[64] += 27
+
+	return hexutil.Encode(sig)
+}
+
+func TestTransferWithPermit(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+	to := "0x000000000000000000000000000000000000f2"
+
+	fromAddr := sdk.AccAddress(crypto.PubkeyToAddress(privateKey.PublicKey).Bytes())
+	require.NoError(t, keeper.SetBalance(ctx, testDenom, fromAddr, math.NewInt(1000)))
+
+	msg := MsgTransferWithPermit{
+		From:     from,
+		To:       to,
+		Amount:   "100",
+		Denom:    testDenom,
+		Nonce:    0,
+		Deadline: sdk.UnwrapSDKContext(ctx).BlockTime().Unix() + 3600,
+	}
+	msg.Signature = signPermit(t, hexutil.Encode(crypto.FromECDSA(privateKey))[2:], msg)
+
+	err = keeper.TransferWithPermit(ctx, msg)
+	require.NoError(t, err)
+
+	fromBalance := keeper.GetBalance(ctx, testDenom, fromAddr)
+	require.Equal(t, math.NewInt(900), fromBalance)
+
+	require.Equal(t, uint64(1), keeper.GetPermitNonce(ctx, from))
+}
+
+func TestTransferWithPermitReplayRejected(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+	fromAddr := sdk.AccAddress(crypto.PubkeyToAddress(privateKey.PublicKey).Bytes())
+	require.NoError(t, keeper.SetBalance(ctx, testDenom, fromAddr, math.NewInt(1000)))
+
+	msg := MsgTransferWithPermit{
+		From:     from,
+		To:       "0x000000000000000000000000000000000000f2",
+		Amount:   "100",
+		Denom:    testDenom,
+		Nonce:    0,
+		Deadline: sdk.UnwrapSDKContext(ctx).BlockTime().Unix() + 3600,
+	}
+	msg.Signature = signPermit(t, hexutil.Encode(crypto.FromECDSA(privateKey))[2:], msg)
+
+	require.NoError(t, keeper.TransferWithPermit(ctx, msg))
+
+	// Replaying the same nonce should fail.
+	err = keeper.TransferWithPermit(ctx, msg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid nonce")
+}
+
+func TestTransferWithPermitExpired(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	msg := MsgTransferWithPermit{
+		From:     from,
+		To:       "0x000000000000000000000000000000000000f2",
+		Amount:   "100",
+		Denom:    testDenom,
+		Nonce:    0,
+		Deadline: sdk.UnwrapSDKContext(ctx).BlockTime().Unix() - 1,
+	}
+	msg.Signature = signPermit(t, hexutil.Encode(crypto.FromECDSA(privateKey))[2:], msg)
+
+	err = keeper.TransferWithPermit(ctx, msg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expired")
+}
+
+func TestTransferWithPermitWrongSigner(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+
+	signerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	claimedKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	msg := MsgTransferWithPermit{
+		From:     crypto.PubkeyToAddress(claimedKey.PublicKey).Hex(),
+		To:       "0x000000000000000000000000000000000000f2",
+		Amount:   "100",
+		Denom:    testDenom,
+		Nonce:    0,
+		Deadline: sdk.UnwrapSDKContext(ctx).BlockTime().Unix() + 3600,
+	}
+	// Signed by a different key than the one claimed in From.
+	msg.Signature = signPermit(t, hexutil.Encode(crypto.FromECDSA(signerKey))[2:], msg)
+
+	err = keeper.TransferWithPermit(ctx, msg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not match")
+}
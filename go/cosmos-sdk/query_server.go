@@ -0,0 +1,103 @@
+package token
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryServer is the server API for the token module's Query service.
+type QueryServer interface {
+	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
+	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
+	AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error)
+	Denoms(context.Context, *QueryDenomsRequest) (*QueryDenomsResponse, error)
+}
+
+type QueryBalanceRequest struct {
+	Address string `json:"address"`
+	Denom   string `json:"denom"`
+}
+
+type QueryBalanceResponse struct {
+	Balance string `json:"balance"`
+}
+
+type QueryTotalSupplyRequest struct {
+	Denom string `json:"denom"`
+}
+
+type QueryTotalSupplyResponse struct {
+	Supply string `json:"supply"`
+}
+
+type QueryAllBalancesRequest struct {
+	Address string `json:"address"`
+}
+
+type Balance struct {
+	Denom   string `json:"denom"`
+	Balance string `json:"balance"`
+}
+
+type QueryAllBalancesResponse struct {
+	Balances []Balance `json:"balances"`
+}
+
+type QueryDenomsRequest struct{}
+
+type QueryDenomsResponse struct {
+	Denoms []Denom `json:"denoms"`
+}
+
+type queryServer struct {
+	keeper TokenKeeper
+}
+
+// NewQueryServerImpl returns an implementation of QueryServer backed by keeper.
+func NewQueryServerImpl(keeper TokenKeeper) QueryServer {
+	return &queryServer{keeper: keeper}
+}
+
+func (q queryServer) Balance(ctx context.Context, req *QueryBalanceRequest) (*QueryBalanceResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	balance := q.keeper.GetBalance(ctx, req.Denom, addr)
+	return &QueryBalanceResponse{Balance: balance.String()}, nil
+}
+
+func (q queryServer) TotalSupply(ctx context.Context, req *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
+	supply := q.keeper.GetTotalSupply(ctx, req.Denom)
+	return &QueryTotalSupplyResponse{Supply: supply.String()}, nil
+}
+
+func (q queryServer) AllBalances(ctx context.Context, req *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	var balances []Balance
+	q.keeper.IterateDenoms(ctx, func(d Denom) bool {
+		bal := q.keeper.GetBalance(ctx, d.Symbol, addr)
+		if !bal.IsZero() {
+			balances = append(balances, Balance{Denom: d.Symbol, Balance: bal.String()})
+		}
+		return true
+	})
+
+	return &QueryAllBalancesResponse{Balances: balances}, nil
+}
+
+func (q queryServer) Denoms(ctx context.Context, req *QueryDenomsRequest) (*QueryDenomsResponse, error) {
+	var denoms []Denom
+	q.keeper.IterateDenoms(ctx, func(d Denom) bool {
+		denoms = append(denoms, d)
+		return true
+	})
+
+	return &QueryDenomsResponse{Denoms: denoms}, nil
+}
@@ -0,0 +1,163 @@
+package token
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+// txBuildingClientContext returns a client.Context wired up with a real
+// TxConfig but no broadcast client, so it can drive a request through
+// broadcastMsg's tx-building path without standing up a node connection this
+// repo has no mock for. With BroadcastMode left unset, clientCtx.BroadcastTx
+// itself returns an "unsupported return type" error rather than dialing out.
+func txBuildingClientContext() client.Context {
+	txConfig := tx.NewTxConfig(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), tx.DefaultSignModes)
+	return client.Context{}.WithTxConfig(txConfig)
+}
+
+// requireBuildsUnsignedTx asserts that msg can be packed into an unsigned tx
+// by the same tx.Factory.BuildUnsignedTx call broadcastMsg uses. This is the
+// step that used to fail to compile (msg didn't satisfy sdk.Msg) and would
+// now fail at runtime instead if msg's proto name weren't registered, so
+// pinning it down here catches a regression in either property directly,
+// rather than inferring it from an HTTP status broadcastMsg could also
+// return for an unrelated reason.
+func requireBuildsUnsignedTx(t *testing.T, msg sdk.Msg) {
+	txConfig := tx.NewTxConfig(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), tx.DefaultSignModes)
+	txf := tx.Factory{}.WithTxConfig(txConfig)
+
+	_, err := txf.BuildUnsignedTx(msg)
+	require.NoError(t, err)
+}
+
+// doRESTRequest POSTs body's JSON encoding to handler and returns the
+// recorded response. The transfer/mint/burn handlers only reach the node's
+// tx-broadcast pipeline after decoding and ValidateBasic succeed, so these
+// tests exercise the request-validation boundary that runs before that.
+func doRESTRequest(t *testing.T, handler http.HandlerFunc, body interface{}) *httptest.ResponseRecorder {
+	bz, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(bz))
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+	return rec
+}
+
+func TestTransferRequestHandlerRejectsInvalidJSON(t *testing.T) {
+	handler := transferRequestHandler(client.Context{})
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("not json")))
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestTransferRequestHandlerRejectsInvalidMsg(t *testing.T) {
+	rec := doRESTRequest(t, transferRequestHandler(client.Context{}), TransferMsg{
+		From: "cosmos1from", Denom: testDenom, Amount: "100",
+	})
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestMintRequestHandlerRejectsInvalidMsg(t *testing.T) {
+	rec := doRESTRequest(t, mintRequestHandler(client.Context{}), MintMsg{
+		Minter: "cosmos1admin", Denom: testDenom, Amount: "100",
+	})
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestBurnRequestHandlerRejectsInvalidMsg(t *testing.T) {
+	rec := doRESTRequest(t, burnRequestHandler(client.Context{}), BurnMsg{
+		Denom: testDenom, Amount: "100",
+	})
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+// TestTransferRequestHandlerBuildsTxForValidMsg drives a valid TransferMsg
+// past ValidateBasic and into broadcastMsg, and separately confirms (via
+// requireBuildsUnsignedTx) that the same message builds into an unsigned tx
+// without error. Together these show the handler reaches the broadcast step
+// at all (the 500 here comes from the intentionally unconfigured client, not
+// from ValidateBasic's 400) and that building the tx along the way succeeds.
+func TestTransferRequestHandlerBuildsTxForValidMsg(t *testing.T) {
+	msg := TransferMsg{
+		From: bech32(sdk.AccAddress("from")), To: bech32(sdk.AccAddress("to")), Denom: testDenom, Amount: "100",
+	}
+	requireBuildsUnsignedTx(t, &msg)
+
+	rec := doRESTRequest(t, transferRequestHandler(txBuildingClientContext()), msg)
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestMintRequestHandlerBuildsTxForValidMsg(t *testing.T) {
+	msg := MintMsg{
+		Minter: bech32(sdk.AccAddress("minter")), Recipient: bech32(sdk.AccAddress("recipient")), Denom: testDenom, Amount: "100",
+	}
+	requireBuildsUnsignedTx(t, &msg)
+
+	rec := doRESTRequest(t, mintRequestHandler(txBuildingClientContext()), msg)
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestBurnRequestHandlerBuildsTxForValidMsg(t *testing.T) {
+	msg := BurnMsg{
+		Burner: bech32(sdk.AccAddress("burner")), Denom: testDenom, Amount: "100",
+	}
+	requireBuildsUnsignedTx(t, &msg)
+
+	rec := doRESTRequest(t, burnRequestHandler(txBuildingClientContext()), msg)
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestBalanceRequestHandler(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+
+	addr := sdk.AccAddress("addr1")
+	require.NoError(t, keeper.SetBalance(ctx, testDenom, addr, math.NewInt(750)))
+
+	handler := balanceRequestHandler(client.Context{}, keeper)
+	req := httptest.NewRequest(http.MethodGet, "/token/balances/"+bech32(addr)+"?denom="+testDenom, nil).WithContext(ctx)
+	req = mux.SetURLVars(req, map[string]string{"addr": bech32(addr)})
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res QueryBalanceResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+	require.Equal(t, "750", res.Balance)
+}
+
+func TestSupplyRequestHandler(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+	require.NoError(t, keeper.SetBalance(ctx, testDenom, sdk.AccAddress("addr1"), math.NewInt(300)))
+
+	handler := supplyRequestHandler(client.Context{}, keeper)
+	req := httptest.NewRequest(http.MethodGet, "/token/supply?denom="+testDenom, nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res QueryTotalSupplyResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+	require.Equal(t, "300", res.Supply)
+}
@@ -2,6 +2,7 @@ package token
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -12,6 +13,8 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const testDenom = "stake"
+
 // setupTestKeeper creates a test keeper and context
 func setupTestKeeper(t *testing.T) (TokenKeeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey("token")
@@ -24,6 +27,99 @@ func setupTestKeeper(t *testing.T) (TokenKeeper, sdk.Context) {
 	return keeper, ctx
 }
 
+// registerTestDenom registers testDenom with the given admin and returns it.
+func registerTestDenom(t *testing.T, keeper TokenKeeper, ctx context.Context, admin sdk.AccAddress) Denom {
+	denom := Denom{
+		Symbol:      testDenom,
+		Name:        "Stake Token",
+		Decimals:    6,
+		Description: "test denom",
+		Admin:       admin,
+	}
+	err := keeper.RegisterDenom(ctx, denom)
+	require.NoError(t, err)
+	return denom
+}
+
+func TestRegisterDenom(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	admin := sdk.AccAddress("admin")
+
+	registerTestDenom(t, keeper, ctx, admin)
+
+	denom, found := keeper.GetDenom(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, testDenom, denom.Symbol)
+	require.Equal(t, admin, denom.Admin)
+}
+
+func TestRegisterDenomAlreadyExists(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	admin := sdk.AccAddress("admin")
+
+	registerTestDenom(t, keeper, ctx, admin)
+
+	err := keeper.RegisterDenom(ctx, Denom{Symbol: testDenom, Admin: admin})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "already registered")
+	require.True(t, errors.Is(err, ErrDenomAlreadyRegistered))
+}
+
+func TestRegisterDenomInvalidInput(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	admin := sdk.AccAddress("admin")
+
+	err := keeper.RegisterDenom(ctx, Denom{Symbol: "", Admin: admin})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInvalidDenom))
+
+	err = keeper.RegisterDenom(ctx, Denom{Symbol: testDenom})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInvalidAddress))
+
+	err = keeper.RegisterDenom(ctx, Denom{Symbol: "a/b", Admin: admin})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInvalidDenom))
+}
+
+// TestRegisterDenomRejectsSlashToAvoidBalancePrefixCollision guards against a
+// denom like "a/b" making GetTotalSupply("a") silently sum "a/b"'s balances
+// too, since balancePrefix("a") = "balance/a/" is a prefix of
+// balanceKey("a/b", addr) = "balance/a/b/<addr>".
+func TestRegisterDenomRejectsSlashToAvoidBalancePrefixCollision(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	admin := sdk.AccAddress("admin")
+
+	registerTestDenom(t, keeper, ctx, admin)
+	err := keeper.RegisterDenom(ctx, Denom{Symbol: testDenom + "/sub", Admin: admin})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInvalidDenom))
+}
+
+func TestGetDenomNotFound(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+
+	_, found := keeper.GetDenom(ctx, "missing")
+	require.False(t, found)
+}
+
+func TestIterateDenoms(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	admin := sdk.AccAddress("admin")
+
+	registerTestDenom(t, keeper, ctx, admin)
+	err := keeper.RegisterDenom(ctx, Denom{Symbol: "atom", Admin: admin})
+	require.NoError(t, err)
+
+	var symbols []string
+	keeper.IterateDenoms(ctx, func(d Denom) bool {
+		symbols = append(symbols, d.Symbol)
+		return true
+	})
+
+	require.ElementsMatch(t, []string{testDenom, "atom"}, symbols)
+}
+
 func TestGetSetBalance(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
 
@@ -31,169 +127,239 @@ func TestGetSetBalance(t *testing.T) {
 	amount := math.NewInt(1000)
 
 	// Initially balance should be zero
-	balance := keeper.GetBalance(ctx, addr)
+	balance := keeper.GetBalance(ctx, testDenom, addr)
 	require.True(t, balance.IsZero())
 
 	// Set balance
-	err := keeper.SetBalance(ctx, addr, amount)
+	err := keeper.SetBalance(ctx, testDenom, addr, amount)
 	require.NoError(t, err)
 
 	// Check balance
-	balance = keeper.GetBalance(ctx, addr)
+	balance = keeper.GetBalance(ctx, testDenom, addr)
 	require.Equal(t, amount, balance)
 }
 
+func TestGetSetBalanceDifferentDenoms(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	addr := sdk.AccAddress("addr1")
+
+	require.NoError(t, keeper.SetBalance(ctx, "stake", addr, math.NewInt(100)))
+	require.NoError(t, keeper.SetBalance(ctx, "atom", addr, math.NewInt(200)))
+
+	require.Equal(t, math.NewInt(100), keeper.GetBalance(ctx, "stake", addr))
+	require.Equal(t, math.NewInt(200), keeper.GetBalance(ctx, "atom", addr))
+}
+
 func TestSetBalanceNegative(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
 
 	addr := sdk.AccAddress("addr1")
 	amount := math.NewInt(-100)
 
-	err := keeper.SetBalance(ctx, addr, amount)
+	err := keeper.SetBalance(ctx, testDenom, addr, amount)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "amount cannot be negative")
 }
 
 func TestTransfer(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
 
 	from := sdk.AccAddress("from")
 	to := sdk.AccAddress("to")
 	amount := math.NewInt(100)
 
 	// Set initial balance for sender
-	err := keeper.SetBalance(ctx, from, math.NewInt(1000))
+	err := keeper.SetBalance(ctx, testDenom, from, math.NewInt(1000))
 	require.NoError(t, err)
 
 	// Transfer
-	err = keeper.Transfer(ctx, from, to, amount)
+	err = keeper.Transfer(ctx, testDenom, from, to, amount)
 	require.NoError(t, err)
 
 	// Check balances
-	fromBalance := keeper.GetBalance(ctx, from)
+	fromBalance := keeper.GetBalance(ctx, testDenom, from)
 	require.Equal(t, math.NewInt(900), fromBalance)
 
-	toBalance := keeper.GetBalance(ctx, to)
+	toBalance := keeper.GetBalance(ctx, testDenom, to)
 	require.Equal(t, amount, toBalance)
 }
 
+func TestTransferUnknownDenom(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+
+	from := sdk.AccAddress("from")
+	to := sdk.AccAddress("to")
+
+	err := keeper.Transfer(ctx, "unknown", from, to, math.NewInt(100))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown denom")
+}
+
 func TestTransferInsufficientFunds(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
 
 	from := sdk.AccAddress("from")
 	to := sdk.AccAddress("to")
 	amount := math.NewInt(100)
 
 	// Set initial balance (less than transfer amount)
-	err := keeper.SetBalance(ctx, from, math.NewInt(50))
+	err := keeper.SetBalance(ctx, testDenom, from, math.NewInt(50))
 	require.NoError(t, err)
 
 	// Transfer should fail
-	err = keeper.Transfer(ctx, from, to, amount)
+	err = keeper.Transfer(ctx, testDenom, from, to, amount)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "insufficient balance")
 }
 
 func TestTransferZeroAmount(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
 
 	from := sdk.AccAddress("from")
 	to := sdk.AccAddress("to")
 	amount := math.ZeroInt()
 
-	err := keeper.Transfer(ctx, from, to, amount)
+	err := keeper.Transfer(ctx, testDenom, from, to, amount)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "transfer amount must be positive")
 }
 
 func TestMint(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
+	admin := sdk.AccAddress("admin")
+	registerTestDenom(t, keeper, ctx, admin)
 
 	addr := sdk.AccAddress("addr1")
 	amount := math.NewInt(500)
 
 	// Mint tokens
-	err := keeper.Mint(ctx, addr, amount)
+	err := keeper.Mint(ctx, testDenom, admin, addr, amount)
 	require.NoError(t, err)
 
 	// Check balance
-	balance := keeper.GetBalance(ctx, addr)
+	balance := keeper.GetBalance(ctx, testDenom, addr)
 	require.Equal(t, amount, balance)
 
 	// Mint more tokens
-	err = keeper.Mint(ctx, addr, amount)
+	err = keeper.Mint(ctx, testDenom, admin, addr, amount)
 	require.NoError(t, err)
 
 	// Check updated balance
-	balance = keeper.GetBalance(ctx, addr)
+	balance = keeper.GetBalance(ctx, testDenom, addr)
 	require.Equal(t, math.NewInt(1000), balance)
 }
 
+func TestMintUnauthorized(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+
+	notAdmin := sdk.AccAddress("impostor")
+	addr := sdk.AccAddress("addr1")
+
+	err := keeper.Mint(ctx, testDenom, notAdmin, addr, math.NewInt(500))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not the admin")
+}
+
 func TestMintZeroAmount(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
+	admin := sdk.AccAddress("admin")
+	registerTestDenom(t, keeper, ctx, admin)
 
 	addr := sdk.AccAddress("addr1")
 	amount := math.ZeroInt()
 
-	err := keeper.Mint(ctx, addr, amount)
+	err := keeper.Mint(ctx, testDenom, admin, addr, amount)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "mint amount must be positive")
 }
 
 func TestBurn(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
+	admin := sdk.AccAddress("admin")
+	registerTestDenom(t, keeper, ctx, admin)
 
 	addr := sdk.AccAddress("addr1")
 	initialAmount := math.NewInt(1000)
 	burnAmount := math.NewInt(300)
 
 	// Set initial balance
-	err := keeper.SetBalance(ctx, addr, initialAmount)
+	err := keeper.SetBalance(ctx, testDenom, addr, initialAmount)
 	require.NoError(t, err)
 
 	// Burn tokens
-	err = keeper.Burn(ctx, addr, burnAmount)
+	err = keeper.Burn(ctx, testDenom, admin, addr, burnAmount)
 	require.NoError(t, err)
 
 	// Check balance
-	balance := keeper.GetBalance(ctx, addr)
+	balance := keeper.GetBalance(ctx, testDenom, addr)
 	require.Equal(t, math.NewInt(700), balance)
 }
 
+func TestBurnUnauthorized(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
+
+	notAdmin := sdk.AccAddress("impostor")
+	addr := sdk.AccAddress("addr1")
+	require.NoError(t, keeper.SetBalance(ctx, testDenom, addr, math.NewInt(1000)))
+
+	err := keeper.Burn(ctx, testDenom, notAdmin, addr, math.NewInt(100))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not the admin")
+}
+
 func TestBurnInsufficientBalance(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
+	admin := sdk.AccAddress("admin")
+	registerTestDenom(t, keeper, ctx, admin)
 
 	addr := sdk.AccAddress("addr1")
 	burnAmount := math.NewInt(500)
 
 	// Set initial balance (less than burn amount)
-	err := keeper.SetBalance(ctx, addr, math.NewInt(300))
+	err := keeper.SetBalance(ctx, testDenom, addr, math.NewInt(300))
 	require.NoError(t, err)
 
 	// Burn should fail
-	err = keeper.Burn(ctx, addr, burnAmount)
+	err = keeper.Burn(ctx, testDenom, admin, addr, burnAmount)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "insufficient balance")
 }
 
 func TestGetTotalSupply(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
+	registerTestDenom(t, keeper, ctx, sdk.AccAddress("admin"))
 
 	addr1 := sdk.AccAddress("addr1")
 	addr2 := sdk.AccAddress("addr2")
 
 	// Set balances
-	err := keeper.SetBalance(ctx, addr1, math.NewInt(1000))
+	err := keeper.SetBalance(ctx, testDenom, addr1, math.NewInt(1000))
 	require.NoError(t, err)
 
-	err = keeper.SetBalance(ctx, addr2, math.NewInt(500))
+	err = keeper.SetBalance(ctx, testDenom, addr2, math.NewInt(500))
 	require.NoError(t, err)
 
 	// Check total supply
-	totalSupply := keeper.GetTotalSupply(ctx)
+	totalSupply := keeper.GetTotalSupply(ctx, testDenom)
 	require.Equal(t, math.NewInt(1500), totalSupply)
 }
 
+func TestGetTotalSupplyIsPerDenom(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	addr := sdk.AccAddress("addr1")
+
+	require.NoError(t, keeper.SetBalance(ctx, "stake", addr, math.NewInt(1000)))
+	require.NoError(t, keeper.SetBalance(ctx, "atom", addr, math.NewInt(250)))
+
+	require.Equal(t, math.NewInt(1000), keeper.GetTotalSupply(ctx, "stake"))
+	require.Equal(t, math.NewInt(250), keeper.GetTotalSupply(ctx, "atom"))
+}
+
 // Message validation tests
 func TestTransferMsgValidateBasic(t *testing.T) {
 	tests := []struct {
@@ -204,8 +370,9 @@ func TestTransferMsgValidateBasic(t *testing.T) {
 		{
 			name: "valid message",
 			msg: TransferMsg{
-				From:   "cosmos1...",
-				To:     "cosmos2...",
+				From:   bech32(sdk.AccAddress("from")),
+				To:     bech32(sdk.AccAddress("to")),
+				Denom:  "stake",
 				Amount: "1000",
 			},
 			wantErr: false,
@@ -214,7 +381,18 @@ func TestTransferMsgValidateBasic(t *testing.T) {
 			name: "empty from",
 			msg: TransferMsg{
 				From:   "",
-				To:     "cosmos2...",
+				To:     bech32(sdk.AccAddress("to")),
+				Denom:  "stake",
+				Amount: "1000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-bech32 from",
+			msg: TransferMsg{
+				From:   "not-a-bech32-addr",
+				To:     bech32(sdk.AccAddress("to")),
+				Denom:  "stake",
 				Amount: "1000",
 			},
 			wantErr: true,
@@ -222,8 +400,19 @@ func TestTransferMsgValidateBasic(t *testing.T) {
 		{
 			name: "empty to",
 			msg: TransferMsg{
-				From:   "cosmos1...",
+				From:   bech32(sdk.AccAddress("from")),
 				To:     "",
+				Denom:  "stake",
+				Amount: "1000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty denom",
+			msg: TransferMsg{
+				From:   bech32(sdk.AccAddress("from")),
+				To:     bech32(sdk.AccAddress("to")),
+				Denom:  "",
 				Amount: "1000",
 			},
 			wantErr: true,
@@ -231,8 +420,9 @@ func TestTransferMsgValidateBasic(t *testing.T) {
 		{
 			name: "empty amount",
 			msg: TransferMsg{
-				From:   "cosmos1...",
-				To:     "cosmos2...",
+				From:   bech32(sdk.AccAddress("from")),
+				To:     bech32(sdk.AccAddress("to")),
+				Denom:  "stake",
 				Amount: "",
 			},
 			wantErr: true,
@@ -260,15 +450,49 @@ func TestMintMsgValidateBasic(t *testing.T) {
 		{
 			name: "valid message",
 			msg: MintMsg{
-				Recipient: "cosmos1...",
+				Minter:    bech32(sdk.AccAddress("minter")),
+				Recipient: bech32(sdk.AccAddress("recipient")),
+				Denom:     "stake",
 				Amount:    "1000",
 			},
 			wantErr: false,
 		},
+		{
+			name: "empty minter",
+			msg: MintMsg{
+				Minter:    "",
+				Recipient: bech32(sdk.AccAddress("recipient")),
+				Denom:     "stake",
+				Amount:    "1000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-bech32 minter",
+			msg: MintMsg{
+				Minter:    "not-a-bech32-addr",
+				Recipient: bech32(sdk.AccAddress("recipient")),
+				Denom:     "stake",
+				Amount:    "1000",
+			},
+			wantErr: true,
+		},
 		{
 			name: "empty recipient",
 			msg: MintMsg{
+				Minter:    bech32(sdk.AccAddress("minter")),
 				Recipient: "",
+				Denom:     "stake",
+				Amount:    "1000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty denom",
+			msg: MintMsg{
+				Minter:    bech32(sdk.AccAddress("minter")),
+				Recipient: bech32(sdk.AccAddress("recipient")),
+				Denom:     "",
 				Amount:    "1000",
 			},
 			wantErr: true,
@@ -276,7 +500,9 @@ func TestMintMsgValidateBasic(t *testing.T) {
 		{
 			name: "empty amount",
 			msg: MintMsg{
-				Recipient: "cosmos1...",
+				Minter:    bech32(sdk.AccAddress("minter")),
+				Recipient: bech32(sdk.AccAddress("recipient")),
+				Denom:     "stake",
 				Amount:    "",
 			},
 			wantErr: true,
@@ -304,7 +530,8 @@ func TestBurnMsgValidateBasic(t *testing.T) {
 		{
 			name: "valid message",
 			msg: BurnMsg{
-				Burner: "cosmos1...",
+				Burner: bech32(sdk.AccAddress("burner")),
+				Denom:  "stake",
 				Amount: "1000",
 			},
 			wantErr: false,
@@ -313,6 +540,25 @@ func TestBurnMsgValidateBasic(t *testing.T) {
 			name: "empty burner",
 			msg: BurnMsg{
 				Burner: "",
+				Denom:  "stake",
+				Amount: "1000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-bech32 burner",
+			msg: BurnMsg{
+				Burner: "not-a-bech32-addr",
+				Denom:  "stake",
+				Amount: "1000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty denom",
+			msg: BurnMsg{
+				Burner: bech32(sdk.AccAddress("burner")),
+				Denom:  "",
 				Amount: "1000",
 			},
 			wantErr: true,
@@ -320,7 +566,8 @@ func TestBurnMsgValidateBasic(t *testing.T) {
 		{
 			name: "empty amount",
 			msg: BurnMsg{
-				Burner: "cosmos1...",
+				Burner: bech32(sdk.AccAddress("burner")),
+				Denom:  "stake",
 				Amount: "",
 			},
 			wantErr: true,
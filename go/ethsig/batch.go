@@ -0,0 +1,85 @@
+package ethsig
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// SignatureItem is one off-chain signature to verify in a
+// VerifySignaturesBatch call. Options controls how Message is hashed
+// before recovery (EIP-191, raw, or an EIP-712 digest) and which chain id
+// and compact-signature rules apply; the zero value matches VerifySignature
+// (EIP-191 personal_sign hashing, standard 65-byte signatures).
+type SignatureItem struct {
+	Address   string
+	Message   string
+	Signature string
+	Options   VerifyOptions
+}
+
+// BatchResult is VerifySignaturesBatch's per-item outcome.
+type BatchResult struct {
+	Valid bool
+	Err   error
+}
+
+// AllValid reports whether every result in results is valid and
+// error-free, the aggregate check callers typically want after a batch.
+func AllValid(results []BatchResult) bool {
+	for _, r := range results {
+		if r.Err != nil || !r.Valid {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifySignaturesBatch verifies items concurrently across a worker pool
+// sized to runtime.GOMAXPROCS, returning one BatchResult per item in input
+// order. It stops dispatching new work as soon as ctx is canceled; items
+// not yet dispatched at that point get ctx.Err() as their result, and the
+// call itself returns ctx.Err(). This is the batch counterpart to
+// VerifySignatureWithOptions, for services (order books, relayers, auth
+// middleware) that would otherwise verify many off-chain messages in a
+// serial loop.
+func (sv *SignatureVerifier) VerifySignaturesBatch(ctx context.Context, items []SignatureItem) ([]BatchResult, error) {
+	results := make([]BatchResult, len(items))
+	if len(items) == 0 {
+		return results, nil
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				valid, err := sv.VerifySignatureWithOptions(items[i].Address, items[i].Message, items[i].Signature, items[i].Options)
+				results[i] = BatchResult{Valid: valid, Err: err}
+			}
+		}()
+	}
+
+dispatch:
+	for i := range items {
+		select {
+		case indices <- i:
+		case <-ctx.Done():
+			for ; i < len(items); i++ {
+				results[i] = BatchResult{Err: ctx.Err()}
+			}
+			break dispatch
+		}
+	}
+	close(indices)
+	wg.Wait()
+
+	return results, ctx.Err()
+}
@@ -0,0 +1,103 @@
+package ethsig
+
+import (
+	"context"
+	"testing"
+)
+
+func TestVerifySignaturesBatchAllValid(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	items := make([]SignatureItem, 0, 8)
+	for i := 0; i < 8; i++ {
+		address, privateKey, err := sv.GenerateKeyPair()
+		if err != nil {
+			t.Fatalf("Failed to generate key pair: %v", err)
+		}
+		message := "batch message"
+		signature, err := sv.SignMessage(privateKey, message)
+		if err != nil {
+			t.Fatalf("SignMessage failed: %v", err)
+		}
+
+		items = append(items, SignatureItem{
+			Address:   address,
+			Message:   message,
+			Signature: signature,
+			Options:   VerifyOptions{Prefix: PrefixEIP191},
+		})
+	}
+
+	results, err := sv.VerifySignaturesBatch(context.Background(), items)
+	if err != nil {
+		t.Fatalf("VerifySignaturesBatch failed: %v", err)
+	}
+	if len(results) != len(items) {
+		t.Fatalf("expected %d results, got %d", len(items), len(results))
+	}
+	if !AllValid(results) {
+		t.Error("expected every item to verify")
+	}
+}
+
+func TestVerifySignaturesBatchReportsPerItemFailure(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	address, privateKey, err := sv.GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+	message := "batch message"
+	signature, err := sv.SignMessage(privateKey, message)
+	if err != nil {
+		t.Fatalf("SignMessage failed: %v", err)
+	}
+
+	items := []SignatureItem{
+		{Address: address, Message: message, Signature: signature, Options: VerifyOptions{Prefix: PrefixEIP191}},
+		{Address: address, Message: "a different message", Signature: signature, Options: VerifyOptions{Prefix: PrefixEIP191}},
+	}
+
+	results, err := sv.VerifySignaturesBatch(context.Background(), items)
+	if err != nil {
+		t.Fatalf("VerifySignaturesBatch failed: %v", err)
+	}
+	if !results[0].Valid {
+		t.Error("expected the first item to verify")
+	}
+	if results[1].Valid {
+		t.Error("expected the second item, signed over a different message, to fail")
+	}
+	if AllValid(results) {
+		t.Error("AllValid should be false when any item is invalid")
+	}
+}
+
+func TestVerifySignaturesBatchHonorsCanceledContext(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	items := []SignatureItem{{Address: "0x0", Message: "m", Signature: "0x00"}}
+
+	results, err := sv.VerifySignaturesBatch(ctx, items)
+	if err == nil {
+		t.Error("expected ctx.Err() to be returned for an already-canceled context")
+	}
+	if results[0].Err == nil {
+		t.Error("expected the item to carry the cancellation error")
+	}
+}
+
+func TestVerifySignaturesBatchEmpty(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	results, err := sv.VerifySignaturesBatch(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("VerifySignaturesBatch failed: %v", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("expected no results, got %d", len(results))
+	}
+}
@@ -0,0 +1,99 @@
+package ethsig
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+)
+
+// EncryptECIES encrypts plaintext under the Ethereum public key pubKeyHex
+// (an uncompressed secp256k1 public key, with or without a 0x prefix)
+// using ECIES, so only the holder of the matching private key can decrypt
+// it. This lets callers do end-to-end encrypted messaging keyed off
+// Ethereum identities rather than a separate key-exchange mechanism.
+func EncryptECIES(pubKeyHex string, plaintext []byte) ([]byte, error) {
+	pubKeyBytes, err := hex.DecodeString(stripHexPrefix(pubKeyHex))
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	pubKey, err := crypto.UnmarshalPubkey(pubKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	ciphertext, err := ecies.Encrypt(rand.Reader, ecies.ImportECDSAPublic(pubKey), plaintext, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ECIES encryption failed: %w", err)
+	}
+	return ciphertext, nil
+}
+
+// DecryptECIES decrypts ciphertext produced by EncryptECIES using the
+// Ethereum private key privKeyHex.
+func DecryptECIES(privKeyHex string, ciphertext []byte) ([]byte, error) {
+	privKey, err := crypto.HexToECDSA(stripHexPrefix(privKeyHex))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	plaintext, err := ecies.ImportECDSA(privKey).Decrypt(ciphertext, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ECIES decryption failed: %w", err)
+	}
+	return plaintext, nil
+}
+
+// EncryptAESGCM encrypts plaintext with key using AES-GCM, prepending the
+// random 12-byte nonce it generates to the returned ciphertext so
+// DecryptAESGCM can recover it.
+func EncryptAESGCM(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// DecryptAESGCM decrypts ciphertext produced by EncryptAESGCM with key,
+// reading the 12-byte nonce back off its front.
+func DecryptAESGCM(key, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext shorter than nonce size %d", gcm.NonceSize())
+	}
+
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("AES-GCM decryption failed: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AES key: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("initializing AES-GCM: %w", err)
+	}
+	return gcm, nil
+}
@@ -0,0 +1,112 @@
+package ethsig
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestECIESEncryptDecryptRoundTrip(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	pubKeyHex := hex.EncodeToString(crypto.FromECDSAPub(&privateKey.PublicKey))
+	privKeyHex := hex.EncodeToString(crypto.FromECDSA(privateKey))
+
+	plaintext := []byte("a secret message for the holder of this key")
+
+	ciphertext, err := EncryptECIES(pubKeyHex, plaintext)
+	if err != nil {
+		t.Fatalf("EncryptECIES failed: %v", err)
+	}
+
+	decrypted, err := DecryptECIES(privKeyHex, ciphertext)
+	if err != nil {
+		t.Fatalf("DecryptECIES failed: %v", err)
+	}
+	if string(decrypted) != string(plaintext) {
+		t.Errorf("decrypted = %q, want %q", decrypted, plaintext)
+	}
+}
+
+func TestECIESDecryptFailsWithWrongKey(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	pubKeyHex := hex.EncodeToString(crypto.FromECDSAPub(&privateKey.PublicKey))
+
+	otherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	otherPrivKeyHex := hex.EncodeToString(crypto.FromECDSA(otherKey))
+
+	ciphertext, err := EncryptECIES(pubKeyHex, []byte("secret"))
+	if err != nil {
+		t.Fatalf("EncryptECIES failed: %v", err)
+	}
+
+	if _, err := DecryptECIES(otherPrivKeyHex, ciphertext); err == nil {
+		t.Error("DecryptECIES should fail with the wrong private key")
+	}
+}
+
+func TestAESGCMEncryptDecryptRoundTrip(t *testing.T) {
+	key := make([]byte, 32)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	plaintext := []byte("AES-GCM round trip message")
+
+	ciphertext, err := EncryptAESGCM(key, plaintext)
+	if err != nil {
+		t.Fatalf("EncryptAESGCM failed: %v", err)
+	}
+	if len(ciphertext) < 12 {
+		t.Fatalf("ciphertext too short to contain a 12-byte nonce: %d bytes", len(ciphertext))
+	}
+
+	decrypted, err := DecryptAESGCM(key, ciphertext)
+	if err != nil {
+		t.Fatalf("DecryptAESGCM failed: %v", err)
+	}
+	if string(decrypted) != string(plaintext) {
+		t.Errorf("decrypted = %q, want %q", decrypted, plaintext)
+	}
+}
+
+func TestAESGCMDecryptFailsWithWrongKey(t *testing.T) {
+	key := make([]byte, 32)
+	wrongKey := make([]byte, 32)
+	wrongKey[0] = 1
+
+	ciphertext, err := EncryptAESGCM(key, []byte("secret"))
+	if err != nil {
+		t.Fatalf("EncryptAESGCM failed: %v", err)
+	}
+
+	if _, err := DecryptAESGCM(wrongKey, ciphertext); err == nil {
+		t.Error("DecryptAESGCM should fail with the wrong key")
+	}
+}
+
+func TestAESGCMProducesDistinctCiphertextsPerCall(t *testing.T) {
+	key := make([]byte, 32)
+	plaintext := []byte("same message")
+
+	c1, err := EncryptAESGCM(key, plaintext)
+	if err != nil {
+		t.Fatalf("EncryptAESGCM failed: %v", err)
+	}
+	c2, err := EncryptAESGCM(key, plaintext)
+	if err != nil {
+		t.Fatalf("EncryptAESGCM failed: %v", err)
+	}
+
+	if string(c1) == string(c2) {
+		t.Error("encrypting the same message twice should use a fresh random nonce")
+	}
+}
@@ -0,0 +1,238 @@
+package ethsig
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Standard and light scrypt parameters, matching go-ethereum's keystore
+// package: Standard favors security for long-term storage, Light favors
+// speed for interactive use.
+const (
+	StandardScryptN = 1 << 18
+	StandardScryptP = 1
+	LightScryptN    = 1 << 12
+	LightScryptP    = 6
+)
+
+const (
+	scryptR         = 8
+	scryptDKLen     = 32
+	keystoreVersion = 3
+)
+
+// keystoreJSON is the Web3 Secret Storage v3 on-disk format produced by
+// EncryptKey and consumed by DecryptKey.
+type keystoreJSON struct {
+	Address string           `json:"address"`
+	Crypto  keystoreCryptoV3 `json:"crypto"`
+	ID      string           `json:"id"`
+	Version int              `json:"version"`
+}
+
+type keystoreCryptoV3 struct {
+	Cipher       string         `json:"cipher"`
+	CipherText   string         `json:"ciphertext"`
+	CipherParams cipherParamsV3 `json:"cipherparams"`
+	KDF          string         `json:"kdf"`
+	KDFParams    scryptParamsV3 `json:"kdfparams"`
+	MAC          string         `json:"mac"`
+}
+
+type cipherParamsV3 struct {
+	IV string `json:"iv"`
+}
+
+type scryptParamsV3 struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	P     int    `json:"p"`
+	R     int    `json:"r"`
+	Salt  string `json:"salt"`
+}
+
+// EncryptKey encrypts the private key privateKeyHex under passphrase,
+// producing the standard go-ethereum Web3 Secret Storage v3 JSON: an
+// AES-128-CTR ciphertext keyed by the first 16 bytes of an scrypt-derived
+// key, MAC'd with Keccak256(derivedKey[16:32] || ciphertext).
+func EncryptKey(privateKeyHex, passphrase string, scryptN, scryptP int) ([]byte, error) {
+	privateKeyHex = stripHexPrefix(privateKeyHex)
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	privateKeyBytes := crypto.FromECDSA(privateKey)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("generating salt: %w", err)
+	}
+
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptDKLen)
+	if err != nil {
+		return nil, fmt.Errorf("deriving key: %w", err)
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, fmt.Errorf("generating iv: %w", err)
+	}
+
+	cipherText, err := aesCTRXOR(derivedKey[:16], privateKeyBytes, iv)
+	if err != nil {
+		return nil, fmt.Errorf("encrypting key: %w", err)
+	}
+
+	mac := crypto.Keccak256(append(derivedKey[16:32], cipherText...))
+
+	id, err := newRandomUUID()
+	if err != nil {
+		return nil, fmt.Errorf("generating keystore id: %w", err)
+	}
+
+	ks := keystoreJSON{
+		Address: address.Hex()[2:],
+		Crypto: keystoreCryptoV3{
+			Cipher:       "aes-128-ctr",
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: cipherParamsV3{IV: hex.EncodeToString(iv)},
+			KDF:          "scrypt",
+			KDFParams: scryptParamsV3{
+				DKLen: scryptDKLen,
+				N:     scryptN,
+				P:     scryptP,
+				R:     scryptR,
+				Salt:  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(mac),
+		},
+		ID:      id,
+		Version: keystoreVersion,
+	}
+
+	return json.Marshal(ks)
+}
+
+// DecryptKey recovers the private key and address from keystoreJSON, a
+// Web3 Secret Storage v3 document produced by EncryptKey, given the
+// passphrase it was encrypted under.
+func DecryptKey(keystoreJSONBytes []byte, passphrase string) (privateKeyHex, address string, err error) {
+	var ks keystoreJSON
+	if err := json.Unmarshal(keystoreJSONBytes, &ks); err != nil {
+		return "", "", fmt.Errorf("invalid keystore JSON: %w", err)
+	}
+	if ks.Version != keystoreVersion {
+		return "", "", fmt.Errorf("unsupported keystore version %d", ks.Version)
+	}
+	if ks.Crypto.Cipher != "aes-128-ctr" {
+		return "", "", fmt.Errorf("unsupported cipher %q", ks.Crypto.Cipher)
+	}
+	if ks.Crypto.KDF != "scrypt" {
+		return "", "", fmt.Errorf("unsupported KDF %q", ks.Crypto.KDF)
+	}
+
+	salt, err := hex.DecodeString(ks.Crypto.KDFParams.Salt)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid salt: %w", err)
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid ciphertext: %w", err)
+	}
+	iv, err := hex.DecodeString(ks.Crypto.CipherParams.IV)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid iv: %w", err)
+	}
+	wantMAC, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid mac: %w", err)
+	}
+
+	p := ks.Crypto.KDFParams
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, p.N, p.R, p.P, p.DKLen)
+	if err != nil {
+		return "", "", fmt.Errorf("deriving key: %w", err)
+	}
+
+	gotMAC := crypto.Keccak256(append(derivedKey[16:32], cipherText...))
+	if !macsEqual(gotMAC, wantMAC) {
+		return "", "", fmt.Errorf("could not decrypt key with given passphrase")
+	}
+
+	privateKeyBytes, err := aesCTRXOR(derivedKey[:16], cipherText, iv)
+	if err != nil {
+		return "", "", fmt.Errorf("decrypting key: %w", err)
+	}
+
+	privateKey, err := crypto.ToECDSA(privateKeyBytes)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid decrypted key: %w", err)
+	}
+
+	return hex.EncodeToString(privateKeyBytes), crypto.PubkeyToAddress(privateKey.PublicKey).Hex(), nil
+}
+
+// GenerateKeyPairEncrypted generates a new Ethereum key pair and
+// immediately encrypts it under passphrase using the standard scrypt
+// parameters, so the raw private key never needs to leave this function.
+func (sv *SignatureVerifier) GenerateKeyPairEncrypted(passphrase string) (address string, keystoreJSONBytes []byte, err error) {
+	address, privateKey, err := sv.GenerateKeyPair()
+	if err != nil {
+		return "", nil, err
+	}
+
+	keystoreJSONBytes, err = EncryptKey(privateKey, passphrase, StandardScryptN, StandardScryptP)
+	if err != nil {
+		return "", nil, fmt.Errorf("encrypting generated key: %w", err)
+	}
+
+	return address, keystoreJSONBytes, nil
+}
+
+// aesCTRXOR encrypts or decrypts (the operations are identical under CTR
+// mode) in with key and iv.
+func aesCTRXOR(key, in, iv []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, len(in))
+	stream := cipher.NewCTR(block, iv)
+	stream.XORKeyStream(out, in)
+	return out, nil
+}
+
+// macsEqual reports whether a and b are equal without leaking timing
+// information about where they first differ.
+func macsEqual(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	var diff byte
+	for i := range a {
+		diff |= a[i] ^ b[i]
+	}
+	return diff == 0
+}
+
+// newRandomUUID generates a random RFC 4122 version 4 UUID string for the
+// keystore's "id" field.
+func newRandomUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,70 @@
+package ethsig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEncryptKeyAndDecryptKeyRoundTrip(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	address, privateKey, err := sv.GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+
+	keystoreJSONBytes, err := EncryptKey(privateKey, "correct horse battery staple", LightScryptN, LightScryptP)
+	if err != nil {
+		t.Fatalf("EncryptKey failed: %v", err)
+	}
+
+	recoveredKey, recoveredAddress, err := DecryptKey(keystoreJSONBytes, "correct horse battery staple")
+	if err != nil {
+		t.Fatalf("DecryptKey failed: %v", err)
+	}
+
+	if !strings.EqualFold(recoveredKey, stripHexPrefix(privateKey)) {
+		t.Errorf("recovered private key %s, want %s", recoveredKey, privateKey)
+	}
+	if !strings.EqualFold(recoveredAddress, address) {
+		t.Errorf("recovered address %s, want %s", recoveredAddress, address)
+	}
+}
+
+func TestDecryptKeyRejectsWrongPassphrase(t *testing.T) {
+	sv := NewSignatureVerifier()
+	_, privateKey, err := sv.GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+
+	keystoreJSONBytes, err := EncryptKey(privateKey, "right passphrase", LightScryptN, LightScryptP)
+	if err != nil {
+		t.Fatalf("EncryptKey failed: %v", err)
+	}
+
+	_, _, err = DecryptKey(keystoreJSONBytes, "wrong passphrase")
+	if err == nil {
+		t.Error("DecryptKey should fail with the wrong passphrase")
+	}
+}
+
+func TestGenerateKeyPairEncrypted(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	address, keystoreJSONBytes, err := sv.GenerateKeyPairEncrypted("a strong passphrase")
+	if err != nil {
+		t.Fatalf("GenerateKeyPairEncrypted failed: %v", err)
+	}
+
+	recoveredKey, recoveredAddress, err := DecryptKey(keystoreJSONBytes, "a strong passphrase")
+	if err != nil {
+		t.Fatalf("DecryptKey failed: %v", err)
+	}
+	if recoveredKey == "" {
+		t.Error("expected a non-empty recovered private key")
+	}
+	if !strings.EqualFold(recoveredAddress, address) {
+		t.Errorf("recovered address %s, want %s", recoveredAddress, address)
+	}
+}
@@ -0,0 +1,149 @@
+package ethsig
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SignaturePrefix selects how VerifySignatureWithOptions hashes message
+// before recovering the signer.
+type SignaturePrefix int
+
+const (
+	// PrefixNone hashes message with a raw Keccak256, matching SignRaw/VerifyRaw.
+	PrefixNone SignaturePrefix = iota
+	// PrefixEIP191 applies the personal_sign prefix, matching SignMessage/VerifySignature.
+	PrefixEIP191
+	// PrefixEIP712Digest treats message as an already-computed EIP-712 signing
+	// digest (32 hex-encoded bytes, as returned by SignTypedData's hashing),
+	// so no further hashing is applied.
+	PrefixEIP712Digest
+)
+
+// VerifyOptions configures VerifySignatureWithOptions.
+type VerifyOptions struct {
+	// ChainID, if set, accepts an EIP-155 replay-protected v
+	// (v = chainId*2 + 35 + yParity) in addition to legacy v values.
+	ChainID *big.Int
+	// Prefix selects how message is hashed before recovery.
+	Prefix SignaturePrefix
+	// AllowCompact accepts 64-byte EIP-2098 compact signatures in addition
+	// to the standard 65-byte [R||S||V] form.
+	AllowCompact bool
+}
+
+// VerifySignatureWithOptions verifies sig against address and message,
+// supporting EIP-2098 compact signatures and EIP-155 chain-id-encoded v
+// values on top of the legacy v ∈ {27,28} and {0,1} forms VerifySignature
+// and VerifyRaw understand.
+func (sv *SignatureVerifier) VerifySignatureWithOptions(address, message, sigHex string, opts VerifyOptions) (bool, error) {
+	hash, err := hashForPrefix(message, opts.Prefix)
+	if err != nil {
+		return false, err
+	}
+
+	sig, err := decodeSignatureWithOptions(sigHex, opts)
+	if err != nil {
+		return false, err
+	}
+
+	publicKeyECDSA, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return false, fmt.Errorf("failed to recover public key: %w", err)
+	}
+
+	recoveredAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
+	expectedAddress := common.HexToAddress(address)
+
+	return recoveredAddress == expectedAddress, nil
+}
+
+func hashForPrefix(message string, prefix SignaturePrefix) (common.Hash, error) {
+	switch prefix {
+	case PrefixNone:
+		return crypto.Keccak256Hash([]byte(message)), nil
+	case PrefixEIP191:
+		return EthereumSignedMessageHash([]byte(message)), nil
+	case PrefixEIP712Digest:
+		digest, err := hexToBytes(message)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("invalid EIP-712 digest: %w", err)
+		}
+		if len(digest) != 32 {
+			return common.Hash{}, fmt.Errorf("EIP-712 digest must be 32 bytes, got %d", len(digest))
+		}
+		return common.BytesToHash(digest), nil
+	default:
+		return common.Hash{}, fmt.Errorf("unknown signature prefix %d", prefix)
+	}
+}
+
+// decodeSignatureWithOptions decodes sigHex into the 65-byte [R||S||V] form
+// crypto.SigToPub expects, accepting EIP-2098 compact signatures and
+// EIP-155 chain-id-encoded v values per opts.
+func decodeSignatureWithOptions(sigHex string, opts VerifyOptions) ([]byte, error) {
+	sigHex = stripHexPrefix(sigHex)
+	raw, err := hex.DecodeString(sigHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	switch len(raw) {
+	case 64:
+		if !opts.AllowCompact {
+			return nil, fmt.Errorf("64-byte compact signatures require VerifyOptions.AllowCompact")
+		}
+		return decodeCompactSignature(raw), nil
+	case 65:
+		return normalizeSignatureV(raw, opts.ChainID)
+	default:
+		return nil, fmt.Errorf("signature must be 64 or 65 bytes, got %d", len(raw))
+	}
+}
+
+// decodeCompactSignature splits an EIP-2098 compact signature into r (bytes
+// 0..31) and yParityAndS (bytes 32..63), recovers yParity from the top bit
+// of yParityAndS and clears it to get s, and reassembles a 65-byte
+// [R||S||V] signature with V = yParity.
+func decodeCompactSignature(raw []byte) []byte {
+	sig := make([]byte, 65)
+	copy(sig[:32], raw[:32])
+	copy(sig[32:64], raw[32:64])
+
+	yParity := sig[32] >> 7
+	sig[32] &= 0x7f
+	sig[64] = yParity
+
+	return sig
+}
+
+// normalizeSignatureV rewrites a 65-byte signature's trailing v byte to the
+// 0/1 recovery id crypto.SigToPub expects, accepting legacy v ∈ {27,28},
+// an already-normalized v ∈ {0,1}, and, when chainID is set, an EIP-155
+// replay-protected v = chainId*2 + 35 + yParity.
+func normalizeSignatureV(raw []byte, chainID *big.Int) ([]byte, error) {
+	sig := append([]byte(nil), raw...)
+	v := sig[64]
+
+	switch {
+	case v == 0 || v == 1:
+		return sig, nil
+	case v == 27 || v == 28:
+		sig[64] = v - 27
+		return sig, nil
+	case chainID != nil && v >= 35:
+		base := new(big.Int).Add(new(big.Int).Mul(chainID, big.NewInt(2)), big.NewInt(35))
+		yParity := new(big.Int).Sub(big.NewInt(int64(v)), base)
+		if yParity.Sign() < 0 || yParity.Cmp(big.NewInt(1)) > 0 {
+			return nil, fmt.Errorf("v=%d is not a valid EIP-155 signature for chain id %s", v, chainID)
+		}
+		sig[64] = byte(yParity.Int64())
+		return sig, nil
+	default:
+		return nil, fmt.Errorf("unrecognized recovery id %d", v)
+	}
+}
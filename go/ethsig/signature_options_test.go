@@ -0,0 +1,154 @@
+package ethsig
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestVerifySignatureWithOptionsAcceptsCompactSignature(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	address, privateKey, err := sv.GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+
+	message := "Compact signature test"
+	signature, err := sv.SignMessage(privateKey, message)
+	if err != nil {
+		t.Fatalf("SignMessage failed: %v", err)
+	}
+
+	compact, err := toCompactSignature(signature)
+	if err != nil {
+		t.Fatalf("toCompactSignature failed: %v", err)
+	}
+
+	valid, err := sv.VerifySignatureWithOptions(address, message, compact, VerifyOptions{Prefix: PrefixEIP191, AllowCompact: true})
+	if err != nil {
+		t.Fatalf("VerifySignatureWithOptions failed: %v", err)
+	}
+	if !valid {
+		t.Error("compact signature should verify")
+	}
+}
+
+func TestVerifySignatureWithOptionsRejectsCompactWithoutAllowCompact(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	address, privateKey, _ := sv.GenerateKeyPair()
+	message := "Compact signature test"
+	signature, _ := sv.SignMessage(privateKey, message)
+	compact, err := toCompactSignature(signature)
+	if err != nil {
+		t.Fatalf("toCompactSignature failed: %v", err)
+	}
+
+	_, err = sv.VerifySignatureWithOptions(address, message, compact, VerifyOptions{Prefix: PrefixEIP191})
+	if err == nil {
+		t.Error("expected an error without AllowCompact set")
+	}
+}
+
+func TestVerifySignatureWithOptionsAcceptsEIP155ChainID(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	address, privateKey, _ := sv.GenerateKeyPair()
+	message := "EIP-155 test"
+	signature, err := sv.SignMessage(privateKey, message)
+	if err != nil {
+		t.Fatalf("SignMessage failed: %v", err)
+	}
+
+	chainID := big.NewInt(1)
+	replayProtected, err := toEIP155Signature(signature, chainID)
+	if err != nil {
+		t.Fatalf("toEIP155Signature failed: %v", err)
+	}
+
+	valid, err := sv.VerifySignatureWithOptions(address, message, replayProtected, VerifyOptions{Prefix: PrefixEIP191, ChainID: chainID})
+	if err != nil {
+		t.Fatalf("VerifySignatureWithOptions failed: %v", err)
+	}
+	if !valid {
+		t.Error("EIP-155 encoded signature should verify")
+	}
+
+	// Without the chain ID, the v value isn't recognized.
+	_, err = sv.VerifySignatureWithOptions(address, message, replayProtected, VerifyOptions{Prefix: PrefixEIP191})
+	if err == nil {
+		t.Error("expected an error recovering an EIP-155 v without a chain id")
+	}
+}
+
+func TestVerifySignatureWithOptionsEIP712Digest(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	address, privateKey, _ := sv.GenerateKeyPair()
+	typedData := mailTypedData()
+
+	signature, err := sv.SignTypedData(privateKey, typedData)
+	if err != nil {
+		t.Fatalf("SignTypedData failed: %v", err)
+	}
+
+	digest, err := typedDataHash(typedData)
+	if err != nil {
+		t.Fatalf("typedDataHash failed: %v", err)
+	}
+
+	valid, err := sv.VerifySignatureWithOptions(address, hex.EncodeToString(digest), signature, VerifyOptions{Prefix: PrefixEIP712Digest})
+	if err != nil {
+		t.Fatalf("VerifySignatureWithOptions failed: %v", err)
+	}
+	if !valid {
+		t.Error("EIP-712 digest signature should verify")
+	}
+}
+
+// toCompactSignature converts a standard 65-byte hex signature into its
+// EIP-2098 compact (64-byte) form, for use as test fixtures.
+func toCompactSignature(sigHex string) (string, error) {
+	sigHex = stripHexPrefix(sigHex)
+	raw, err := hex.DecodeString(sigHex)
+	if err != nil {
+		return "", err
+	}
+
+	yParity := raw[64]
+	if yParity == 27 || yParity == 28 {
+		yParity -= 27
+	}
+
+	compact := make([]byte, 64)
+	copy(compact[:32], raw[:32])
+	copy(compact[32:64], raw[32:64])
+	compact[32] |= yParity << 7
+
+	return hex.EncodeToString(compact), nil
+}
+
+// toEIP155Signature rewrites a standard 65-byte hex signature's v to the
+// EIP-155 replay-protected form v = chainId*2 + 35 + yParity.
+func toEIP155Signature(sigHex string, chainID *big.Int) (string, error) {
+	sigHex = stripHexPrefix(sigHex)
+	raw, err := hex.DecodeString(sigHex)
+	if err != nil {
+		return "", err
+	}
+
+	yParity := raw[64]
+	if yParity == 27 || yParity == 28 {
+		yParity -= 27
+	}
+
+	v := new(big.Int).Add(new(big.Int).Mul(chainID, big.NewInt(2)), big.NewInt(35+int64(yParity)))
+	if !v.IsUint64() || v.Uint64() > 255 {
+		return "", fmt.Errorf("chain id %s produces a v value that doesn't fit in one byte", chainID)
+	}
+	raw[64] = byte(v.Uint64())
+
+	return hex.EncodeToString(raw), nil
+}
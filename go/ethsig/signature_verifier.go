@@ -1,10 +1,10 @@
-package main
+package ethsig
 
 import (
 	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
-	"log"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -19,8 +19,30 @@ func NewSignatureVerifier() *SignatureVerifier {
 	return &SignatureVerifier{}
 }
 
-// SignMessage signs a message with a private key
+// EthereumSignedMessageHash reproduces the EIP-191 personal_sign digest that
+// MetaMask, eth_sign, and go-ethereum's accounts.TextHash produce: it
+// prepends "\x19Ethereum Signed Message:\n" + len(message) to message before
+// Keccak256-hashing it, so signatures from real wallets verify against it.
+func EthereumSignedMessageHash(message []byte) common.Hash {
+	prefix := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message))
+	return crypto.Keccak256Hash([]byte(prefix), message)
+}
+
+// SignMessage signs a message with a private key, hashing it with the
+// EIP-191 personal_sign prefix so the signature verifies in MetaMask and
+// other wallets. Use SignRaw to sign the message's raw Keccak256 hash instead.
 func (sv *SignatureVerifier) SignMessage(privateKeyHex, message string) (string, error) {
+	return sv.signHash(privateKeyHex, EthereumSignedMessageHash([]byte(message)))
+}
+
+// SignRaw signs the raw Keccak256 hash of message, without the EIP-191
+// personal_sign prefix. Kept for callers that already relied on that
+// behavior; prefer SignMessage for signatures produced by real wallets.
+func (sv *SignatureVerifier) SignRaw(privateKeyHex, message string) (string, error) {
+	return sv.signHash(privateKeyHex, crypto.Keccak256Hash([]byte(message)))
+}
+
+func (sv *SignatureVerifier) signHash(privateKeyHex string, hash common.Hash) (string, error) {
 	// Remove 0x prefix if present
 	privateKeyHex = stripHexPrefix(privateKeyHex)
 
@@ -30,12 +52,8 @@ func (sv *SignatureVerifier) SignMessage(privateKeyHex, message string) (string,
 		return "", fmt.Errorf("invalid private key: %w", err)
 	}
 
-	// Hash the message (Ethereum signed message format)
-	messageHash := crypto.Keccak256Hash([]byte(message))
-	messageHashBytes := messageHash.Bytes()
-
 	// Sign the hash
-	signature, err := crypto.Sign(messageHashBytes, privateKey)
+	signature, err := crypto.Sign(hash.Bytes(), privateKey)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign message: %w", err)
 	}
@@ -43,17 +61,29 @@ func (sv *SignatureVerifier) SignMessage(privateKeyHex, message string) (string,
 	return hexutil.Encode(signature), nil
 }
 
-// VerifySignature verifies an Ethereum signature
+// VerifySignature verifies an Ethereum signature produced over the EIP-191
+// personal_sign digest of message. Use VerifyRaw to verify against the
+// message's raw Keccak256 hash instead.
 func (sv *SignatureVerifier) VerifySignature(address, message, signatureHex string) (bool, error) {
-	// Hash the message
-	messageHash := crypto.Keccak256Hash([]byte(message))
+	return sv.verifyHash(address, EthereumSignedMessageHash([]byte(message)), signatureHex)
+}
 
+// VerifyRaw verifies an Ethereum signature produced over the raw Keccak256
+// hash of message, without the EIP-191 personal_sign prefix.
+func (sv *SignatureVerifier) VerifyRaw(address, message, signatureHex string) (bool, error) {
+	return sv.verifyHash(address, crypto.Keccak256Hash([]byte(message)), signatureHex)
+}
+
+func (sv *SignatureVerifier) verifyHash(address string, hash common.Hash, signatureHex string) (bool, error) {
 	// Decode signature
 	signatureHex = stripHexPrefix(signatureHex)
 	signature, err := hex.DecodeString(signatureHex)
 	if err != nil {
 		return false, fmt.Errorf("invalid signature: %w", err)
 	}
+	if len(signature) != 65 {
+		return false, fmt.Errorf("signature must be 65 bytes, got %d", len(signature))
+	}
 
 	// Ethereum signatures have v value at the end, adjust it
 	if signature[64] == 27 || signature[64] == 28 {
@@ -61,7 +91,7 @@ func (sv *SignatureVerifier) VerifySignature(address, message, signatureHex stri
 	}
 
 	// Recover public key from signature
-	publicKeyECDSA, err := crypto.SigToPub(messageHash.Bytes(), signature)
+	publicKeyECDSA, err := crypto.SigToPub(hash.Bytes(), signature)
 	if err != nil {
 		return false, fmt.Errorf("failed to recover public key: %w", err)
 	}
@@ -75,17 +105,18 @@ func (sv *SignatureVerifier) VerifySignature(address, message, signatureHex stri
 	return recoveredAddress == expectedAddress, nil
 }
 
-// RecoverAddress recovers the Ethereum address from a signature
+// RecoverAddress recovers the Ethereum address that produced signatureHex
+// over the EIP-191 personal_sign digest of message.
 func (sv *SignatureVerifier) RecoverAddress(message, signatureHex string) (string, error) {
-	// Hash the message
-	messageHash := crypto.Keccak256Hash([]byte(message))
-
 	// Decode signature
 	signatureHex = stripHexPrefix(signatureHex)
 	signature, err := hex.DecodeString(signatureHex)
 	if err != nil {
 		return "", fmt.Errorf("invalid signature: %w", err)
 	}
+	if len(signature) != 65 {
+		return "", fmt.Errorf("signature must be 65 bytes, got %d", len(signature))
+	}
 
 	// Adjust v value
 	if signature[64] == 27 || signature[64] == 28 {
@@ -93,7 +124,7 @@ func (sv *SignatureVerifier) RecoverAddress(message, signatureHex string) (strin
 	}
 
 	// Recover public key
-	publicKeyECDSA, err := crypto.SigToPub(messageHash.Bytes(), signature)
+	publicKeyECDSA, err := crypto.SigToPub(EthereumSignedMessageHash([]byte(message)).Bytes(), signature)
 	if err != nil {
 		return "", fmt.Errorf("failed to recover public key: %w", err)
 	}
@@ -141,57 +172,3 @@ func stripHexPrefix(s string) string {
 	}
 	return s
 }
-
-func main() {
-	sv := NewSignatureVerifier()
-
-	fmt.Println("🔐 Ethereum Signature Verifier")
-	fmt.Println("================================\n")
-
-	// Generate new key pair
-	fmt.Println("1️⃣  Generating new key pair...")
-	address, privateKey, err := sv.GenerateKeyPair()
-	if err != nil {
-		log.Fatal(err)
-	}
-	fmt.Printf("   Address: %s\n", address)
-	fmt.Printf("   Private Key: %s\n\n", privateKey)
-
-	// Sign message
-	message := "Hello, Ethereum!"
-	fmt.Printf("2️⃣  Signing message: %q\n", message)
-	signature, err := sv.SignMessage(privateKey, message)
-	if err != nil {
-		log.Fatal(err)
-	}
-	fmt.Printf("   Signature: %s\n\n", signature)
-
-	// Verify signature
-	fmt.Println("3️⃣  Verifying signature...")
-	valid, err := sv.VerifySignature(address, message, signature)
-	if err != nil {
-		log.Fatal(err)
-	}
-	if valid {
-		fmt.Println("   ✅ Signature is valid!")
-	} else {
-		fmt.Println("   ❌ Signature is invalid!")
-	}
-
-	// Recover address
-	fmt.Println("\n4️⃣  Recovering address from signature...")
-	recoveredAddress, err := sv.RecoverAddress(message, signature)
-	if err != nil {
-		log.Fatal(err)
-	}
-	fmt.Printf("   Recovered Address: %s\n", recoveredAddress)
-	fmt.Printf("   Original Address:  %s\n", address)
-	if recoveredAddress == address {
-		fmt.Println("   ✅ Addresses match!")
-	}
-
-	// Hash message
-	fmt.Println("\n5️⃣  Hashing message...")
-	hash := sv.HashMessage(message)
-	fmt.Printf("   Message Hash: %s\n", hash)
-}
@@ -1,4 +1,4 @@
-package main
+package ethsig
 
 import (
 	"strings"
@@ -132,6 +132,18 @@ func TestVerifySignatureWithInvalidSignature(t *testing.T) {
 	}
 }
 
+func TestVerifySignatureWithShortSignature(t *testing.T) {
+	sv := NewSignatureVerifier()
+	address := "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0"
+
+	// Valid hex but far too short to be a 65-byte [R||S||V] signature; must
+	// be rejected with an error rather than panicking on signature[64].
+	_, err := sv.VerifySignature(address, "Test", "0x1234")
+	if err == nil {
+		t.Error("VerifySignature should fail with a too-short signature")
+	}
+}
+
 func TestRecoverAddress(t *testing.T) {
 	sv := NewSignatureVerifier()
 
@@ -161,6 +173,17 @@ func TestRecoverAddressWithInvalidSignature(t *testing.T) {
 	}
 }
 
+func TestRecoverAddressWithShortSignature(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	// Valid hex but far too short to be a 65-byte [R||S||V] signature; must
+	// be rejected with an error rather than panicking on signature[64].
+	_, err := sv.RecoverAddress("message", "0x1234")
+	if err == nil {
+		t.Error("RecoverAddress should fail with a too-short signature")
+	}
+}
+
 func TestHashMessage(t *testing.T) {
 	sv := NewSignatureVerifier()
 
@@ -287,6 +310,50 @@ func TestMultipleSignatures(t *testing.T) {
 	}
 }
 
+func TestEthereumSignedMessageHashMatchesPersonalSignFormat(t *testing.T) {
+	message := []byte("Test")
+	got := EthereumSignedMessageHash(message)
+
+	want := crypto.Keccak256Hash([]byte("\x19Ethereum Signed Message:\n4Test"))
+	if got != want {
+		t.Errorf("EthereumSignedMessageHash(%q) = %s, want %s", message, got.Hex(), want.Hex())
+	}
+}
+
+func TestSignRawAndVerifyRawRoundTrip(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	address, privateKey, err := sv.GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+
+	message := "Raw signed message"
+
+	signature, err := sv.SignRaw(privateKey, message)
+	if err != nil {
+		t.Fatalf("SignRaw failed: %v", err)
+	}
+
+	valid, err := sv.VerifyRaw(address, message, signature)
+	if err != nil {
+		t.Fatalf("VerifyRaw failed: %v", err)
+	}
+	if !valid {
+		t.Error("Raw signature should be valid under VerifyRaw")
+	}
+
+	// A raw signature should not verify under the EIP-191 personal_sign path,
+	// since the two hash the message differently.
+	valid, err = sv.VerifySignature(address, message, signature)
+	if err != nil {
+		t.Fatalf("VerifySignature failed: %v", err)
+	}
+	if valid {
+		t.Error("Raw signature should not verify against the personal_sign digest")
+	}
+}
+
 // Benchmark tests
 func BenchmarkGenerateKeyPair(b *testing.B) {
 	sv := NewSignatureVerifier()
@@ -0,0 +1,315 @@
+package ethsig
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// SignTypedData signs typedData following EIP-712: the digest is
+// keccak256(0x19 0x01 || domainSeparator || hashStruct(message)), which is
+// the format wallets use for off-chain auth, gasless approvals, and orders.
+func (sv *SignatureVerifier) SignTypedData(privateKeyHex string, typedData apitypes.TypedData) (string, error) {
+	digest, err := typedDataHash(typedData)
+	if err != nil {
+		return "", fmt.Errorf("hashing typed data: %w", err)
+	}
+
+	privateKeyHex = stripHexPrefix(privateKeyHex)
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	if err != nil {
+		return "", fmt.Errorf("invalid private key: %w", err)
+	}
+
+	signature, err := crypto.Sign(digest, privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign typed data: %w", err)
+	}
+
+	return hexutil.Encode(signature), nil
+}
+
+// VerifyTypedData reports whether sigHex is address's EIP-712 signature
+// over typedData.
+func (sv *SignatureVerifier) VerifyTypedData(address string, typedData apitypes.TypedData, sigHex string) (bool, error) {
+	digest, err := typedDataHash(typedData)
+	if err != nil {
+		return false, fmt.Errorf("hashing typed data: %w", err)
+	}
+
+	return sv.verifyHash(address, common.BytesToHash(digest), sigHex)
+}
+
+// typedDataHash computes the EIP-712 signing digest for typedData:
+// keccak256(0x19 0x01 || domainSeparator || messageHash).
+func typedDataHash(typedData apitypes.TypedData) ([]byte, error) {
+	domainSeparator, err := hashStruct("EIP712Domain", domainMap(typedData.Domain), typedData.Types)
+	if err != nil {
+		return nil, fmt.Errorf("domain: %w", err)
+	}
+
+	messageHash, err := hashStruct(typedData.PrimaryType, typedData.Message, typedData.Types)
+	if err != nil {
+		return nil, fmt.Errorf("message: %w", err)
+	}
+
+	payload := append([]byte{0x19, 0x01}, domainSeparator...)
+	payload = append(payload, messageHash...)
+	return crypto.Keccak256(payload), nil
+}
+
+// domainMap reduces domain to a field map keyed the same way as
+// typedData.Types["EIP712Domain"], omitting fields the caller left zero.
+func domainMap(domain apitypes.TypedDataDomain) map[string]interface{} {
+	m := map[string]interface{}{}
+	if domain.Name != "" {
+		m["name"] = domain.Name
+	}
+	if domain.Version != "" {
+		m["version"] = domain.Version
+	}
+	if domain.ChainId != nil {
+		m["chainId"] = (*big.Int)(domain.ChainId)
+	}
+	if domain.VerifyingContract != "" {
+		m["verifyingContract"] = domain.VerifyingContract
+	}
+	if domain.Salt != "" {
+		m["salt"] = domain.Salt
+	}
+	return m
+}
+
+// hashStruct implements EIP-712's hashStruct: keccak256(encodeType(primaryType) || encodeData(data)).
+func hashStruct(primaryType string, data map[string]interface{}, types apitypes.Types) ([]byte, error) {
+	encoded, err := encodeData(primaryType, data, types)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(encoded), nil
+}
+
+// encodeData implements EIP-712's encodeData: the struct's type hash
+// followed by each field's 32-byte encoded value, in declaration order.
+func encodeData(primaryType string, data map[string]interface{}, types apitypes.Types) ([]byte, error) {
+	fields, ok := types[primaryType]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q", primaryType)
+	}
+
+	encoded := typeHash(primaryType, types)
+	for _, field := range fields {
+		value, err := encodeValue(field.Type, data[field.Name], types)
+		if err != nil {
+			return nil, fmt.Errorf("field %s.%s: %w", primaryType, field.Name, err)
+		}
+		encoded = append(encoded, value...)
+	}
+	return encoded, nil
+}
+
+// typeHash implements EIP-712's typeHash: keccak256(encodeType(primaryType)).
+func typeHash(primaryType string, types apitypes.Types) []byte {
+	return crypto.Keccak256([]byte(encodeType(primaryType, types)))
+}
+
+// encodeType renders primaryType's EIP-712 type signature, e.g.
+// "Mail(Person from,Person to,string contents)Person(string name,address wallet)",
+// with primaryType first and every struct type it references (transitively)
+// following in alphabetical order, per the EIP-712 spec.
+func encodeType(primaryType string, types apitypes.Types) string {
+	deps := make(map[string]bool)
+	collectDeps(primaryType, types, deps)
+	delete(deps, primaryType)
+
+	sorted := make([]string, 0, len(deps))
+	for dep := range deps {
+		sorted = append(sorted, dep)
+	}
+	sort.Strings(sorted)
+	sorted = append([]string{primaryType}, sorted...)
+
+	var b strings.Builder
+	for _, name := range sorted {
+		b.WriteString(name)
+		b.WriteByte('(')
+		for i, field := range types[name] {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(field.Type)
+			b.WriteByte(' ')
+			b.WriteString(field.Name)
+		}
+		b.WriteByte(')')
+	}
+	return b.String()
+}
+
+// collectDeps adds primaryType and every struct type referenced by its
+// fields (recursively, through array element types) to deps.
+func collectDeps(primaryType string, types apitypes.Types, deps map[string]bool) {
+	if deps[primaryType] {
+		return
+	}
+	fields, ok := types[primaryType]
+	if !ok {
+		return
+	}
+	deps[primaryType] = true
+
+	for _, field := range fields {
+		base := strings.TrimSuffix(field.Type, "[]")
+		if idx := strings.IndexByte(base, '['); idx >= 0 {
+			base = base[:idx]
+		}
+		if _, isStruct := types[base]; isStruct {
+			collectDeps(base, types, deps)
+		}
+	}
+}
+
+// encodeValue encodes a single field's value to its 32-byte (or, for
+// dynamic array types, variable-length) EIP-712 representation.
+func encodeValue(typ string, value interface{}, types apitypes.Types) ([]byte, error) {
+	if strings.HasSuffix(typ, "]") {
+		idx := strings.LastIndexByte(typ, '[')
+		elemType := typ[:idx]
+
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected array value for type %s, got %T", typ, value)
+		}
+
+		var encoded []byte
+		for i, item := range items {
+			itemEncoded, err := encodeValue(elemType, item, types)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			encoded = append(encoded, itemEncoded...)
+		}
+		return crypto.Keccak256(encoded), nil
+	}
+
+	if _, isStruct := types[typ]; isStruct {
+		fieldData, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected struct value for type %s, got %T", typ, value)
+		}
+		return hashStruct(typ, fieldData, types)
+	}
+
+	switch {
+	case typ == "string":
+		s, err := toString(value)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.Keccak256([]byte(s)), nil
+	case typ == "bytes":
+		b, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.Keccak256(b), nil
+	case typ == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected bool value, got %T", value)
+		}
+		out := make([]byte, 32)
+		if b {
+			out[31] = 1
+		}
+		return out, nil
+	case typ == "address":
+		s, err := toString(value)
+		if err != nil {
+			return nil, err
+		}
+		return common.LeftPadBytes(common.HexToAddress(s).Bytes(), 32), nil
+	case strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "int"):
+		n, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		return math256Bytes(n), nil
+	case strings.HasPrefix(typ, "bytes"):
+		b, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return common.RightPadBytes(b, 32), nil
+	default:
+		return nil, fmt.Errorf("unsupported EIP-712 type %q", typ)
+	}
+}
+
+// math256Bytes encodes n as a signed-or-unsigned 32-byte big-endian word,
+// matching Solidity's ABI encoding of uintN/intN as a 32-byte slot.
+func math256Bytes(n *big.Int) []byte {
+	if n.Sign() >= 0 {
+		return common.LeftPadBytes(n.Bytes(), 32)
+	}
+	// Two's complement for negative intN values.
+	mod := new(big.Int).Lsh(big.NewInt(1), 256)
+	twos := new(big.Int).Add(mod, n)
+	return common.LeftPadBytes(twos.Bytes(), 32)
+}
+
+func toString(value interface{}) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("expected string value, got %T", value)
+	}
+	return s, nil
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return hexToBytes(v)
+	default:
+		return nil, fmt.Errorf("expected bytes value, got %T", value)
+	}
+}
+
+func hexToBytes(s string) ([]byte, error) {
+	s = stripHexPrefix(s)
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	return hex.DecodeString(s)
+}
+
+func toBigInt(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case string:
+		n, ok := new(big.Int).SetString(stripHexPrefix(v), hexOrDecBase(v))
+		if !ok {
+			return nil, fmt.Errorf("invalid integer value %q", v)
+		}
+		return n, nil
+	default:
+		return nil, fmt.Errorf("expected integer value, got %T", value)
+	}
+}
+
+func hexOrDecBase(s string) int {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return 16
+	}
+	return 10
+}